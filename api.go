@@ -45,8 +45,90 @@ func ProvideNamedFn[I any, T any](name string, fn func(ctx context.Context) (T,
 	}
 }
 
+// ProvideToGroup registers a const as a member of the named group.
+// All members of a group are injected into fields of type []I tagged with `pal:"group=<group>"`
+// in registration order. Members are regular singletons and are initialized before the services depending on the group.
+// Lifecycle matches [Provide].
+func ProvideToGroup[I any, T any](group string, value T) Hookable[T] {
+	validateNonNilPointer(value)
+	validateGroupMember[I, T]()
+
+	return &ServiceConst[T]{instance: value, ServiceTyped: ServiceTyped[T]{name: groupMemberName[T](group), group: group}}
+}
+
+// ProvideFnToGroup is like ProvideToGroup but the member is a singleton built with a given function.
+// Lifecycle matches [ProvideFn].
+func ProvideFnToGroup[I any, T any](group string, fn func(ctx context.Context) (T, error)) Hookable[T] {
+	validateFactoryFunction[I, T](fn)
+
+	return &ServiceFnSingleton[I, T]{
+		fn:             fn,
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: groupMemberName[T](group), group: group}},
+	}
+}
+
+// ProvideScoped registers a scoped service built with a given function.
+// A scoped service is created once per [Scope] (see [Pal.NewScope]) on first use and is shut down when the scope is closed.
+// Lifecycle of each scoped instance matches [ProvideFn]: create → inject → ToInit / PalInit / Init.
+// Scoped services may depend on singletons and on other scoped services, but singletons cannot depend on scoped services.
+func ProvideScoped[I any, T any](fn func(ctx context.Context) (T, error)) Hookable[T] {
+	return ProvideNamedScoped[I](typetostring.GetType[I](), fn)
+}
+
+// ProvideNamedScoped is like ProvideScoped but allows to specify a name.
+func ProvideNamedScoped[I any, T any](name string, fn func(ctx context.Context) (T, error)) Hookable[T] {
+	validateFactoryFunction[I, T](fn)
+
+	return &ServiceScoped[I, T]{
+		fn:             fn,
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: name}},
+	}
+}
+
+// ProvideConstructor registers a singleton built with a constructor function of the form
+// func(ctx context.Context, A, B, C...) (T, error). T must be a pointer implementing I.
+// Parameters are resolved from the container by type like untagged fields and must be resolvable,
+// parameters of struct types are parameter objects whose fields are injected respecting `pal` tags,
+// for instance to resolve dependencies by name. The services the parameters resolve to are initialized
+// before the constructor is called. After the constructor returns, the lifecycle matches [ProvideFn].
+func ProvideConstructor[I any](fn any) ConstructorDef {
+	return ProvideNamedConstructor[I](typetostring.GetType[I](), fn)
+}
+
+// ProvideNamedConstructor is like ProvideConstructor but allows to specify a name.
+func ProvideNamedConstructor[I any](name string, fn any) ConstructorDef {
+	validateConstructor[I](fn)
+
+	return &ServiceConstructor[I]{
+		fn:           reflect.ValueOf(fn),
+		params:       newParameters(reflect.TypeOf(fn)),
+		ServiceTyped: ServiceTyped[I]{name: name},
+	}
+}
+
+// Decorate registers a decorator of the singleton service registered as I. The function receives the instance
+// of the service and returns the instance given to the dependents instead, for instance a caching or metrics wrapper.
+// Several decorators of the same service are applied in registration order, each one wrapping the result of the previous one.
+// A decorator may be registered before or after the service it decorates, even in a different module.
+// Dependencies are injected into the returned instance like into any service, except fields resolving to the decorated service.
+// Return a pointer type as T to let Pal initialize the decorator's own dependencies before it.
+func Decorate[I any, T any](fn func(ctx context.Context, inner I) (T, error)) ServiceDef {
+	return DecorateNamed[I](typetostring.GetType[I](), fn)
+}
+
+// DecorateNamed is like Decorate but decorates the service registered with the given name.
+func DecorateNamed[I any, T any](name string, fn func(ctx context.Context, inner I) (T, error)) ServiceDef {
+	validateGroupMember[I, T]()
+
+	return &ServiceDecorator[I, T]{
+		target:       name,
+		fn:           fn,
+		ServiceTyped: ServiceTyped[T]{name: fmt.Sprintf("$decorator-%s-%s", name, randomID())},
+	}
+}
+
 // ProvideRunner turns the given function into an anounumous runner. It will run in the background, and the passed context will
-// be canceled on app shutdown.
+// be canceled on app shutdown. Runners have no init, shutdown or health check phases, so they take no per-service timeouts.
 func ProvideRunner(fn func(ctx context.Context) error) ServiceDef {
 	return &ServiceRunner{
 		fn: fn,
@@ -59,38 +141,71 @@ func ProvideList(services ...ServiceDef) ServiceDef {
 }
 
 // ProvideFactory0 registers a factory service that is build with a given function with no arguments.
-func ProvideFactory0[I any, T any](fn func(ctx context.Context) (T, error)) ServiceDef {
+func ProvideFactory0[I any, T any](fn func(ctx context.Context) (T, error)) FactoryDef {
 	return ProvideNamedFactory0[I](typetostring.GetType[I](), fn)
 }
 
 // ProvideFactory1 registers a factory service that is built in runtime with a given function that takes one argument.
-func ProvideFactory1[I any, T any, P1 any](fn func(ctx context.Context, p1 P1) (T, error)) ServiceDef {
+func ProvideFactory1[I any, T any, P1 any](fn func(ctx context.Context, p1 P1) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 	return ProvideNamedFactory1[I](typetostring.GetType[I](), fn)
 }
 
 // ProvideFactory2 registers a factory service that is built in runtime with a given function that takes two arguments.
-func ProvideFactory2[I any, T any, P1 any, P2 any](fn func(ctx context.Context, p1 P1, p2 P2) (T, error)) ServiceDef {
+func ProvideFactory2[I any, T any, P1 any, P2 any](fn func(ctx context.Context, p1 P1, p2 P2) (T, error)) FactoryDef {
 	return ProvideNamedFactory2[I](typetostring.GetType[I](), fn)
 }
 
 // ProvideFactory3 registers a factory service that is built in runtime with a given function that takes three arguments.
-func ProvideFactory3[I any, T any, P1 any, P2 any, P3 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3) (T, error)) ServiceDef {
+func ProvideFactory3[I any, T any, P1 any, P2 any, P3 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3) (T, error)) FactoryDef {
 	return ProvideNamedFactory3[I](typetostring.GetType[I](), fn)
 }
 
 // ProvideFactory4 registers a factory service that is built in runtime with a given function that takes four arguments.
-func ProvideFactory4[I any, T any, P1 any, P2 any, P3 any, P4 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (T, error)) ServiceDef {
+func ProvideFactory4[I any, T any, P1 any, P2 any, P3 any, P4 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (T, error)) FactoryDef {
 	return ProvideNamedFactory4[I](typetostring.GetType[I](), fn)
 }
 
 // ProvideFactory5 registers a factory service that is built in runtime with a given function that takes five arguments.
-func ProvideFactory5[I any, T any, P1 any, P2 any, P3 any, P4 any, P5 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error)) ServiceDef {
+func ProvideFactory5[I any, T any, P1 any, P2 any, P3 any, P4 any, P5 any](fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error)) FactoryDef {
 	return ProvideNamedFactory5[I](typetostring.GetType[I](), fn)
 }
 
+// ProvideFactoryArgs registers a factory service that is built in runtime with a given function that takes
+// a struct of arguments. Unlike ProvideFactory0–5, it is not limited in the number of arguments, and arguments are
+// named. Invoke it with [InvokeWith] or inject a func(ctx context.Context, args A) (I, error) field.
+func ProvideFactoryArgs[I any, T any, A any](fn func(ctx context.Context, args A) (T, error)) FactoryDef {
+	return ProvideNamedFactoryArgs[I](typetostring.GetType[I](), fn)
+}
+
+// ProvideAssistedFactory registers a factory service that is built in runtime with a given function that takes
+// an argument supplied by the caller and a struct of dependencies. Fields of the dependencies struct are resolved from
+// the container like fields of services and respect `pal` tags, the dependencies are initialized before the factory
+// is invoked. A is typically a struct of arguments, callers invoke the factory with [InvokeWith] or with an injected
+// func(ctx context.Context, args A) (I, error) field.
+func ProvideAssistedFactory[I any, T any, A any, D any](fn func(ctx context.Context, args A, deps D) (T, error)) FactoryDef {
+	return ProvideNamedAssistedFactory[I](typetostring.GetType[I](), fn)
+}
+
+// ProvideKeyed registers a multiton service, one instance is built with the given function for every key and cached,
+// subsequent invocations with the same key return the cached instance. Invoke it with [Invoke] passing the key
+// or inject a func(ctx context.Context, key K) (I, error) field. Cached instances are health checked and shut down
+// along with other services.
+func ProvideKeyed[I any, T any, K comparable](fn func(ctx context.Context, key K) (T, error)) KeyedDef {
+	return ProvideNamedKeyed[I](typetostring.GetType[I](), fn)
+}
+
+// ProvidePool registers a pooled service that keeps up to size initialized instances built with the given function
+// and reuses them. Instances are acquired with an injected func(ctx context.Context) (I, func(), error) field,
+// the returned func() returns the instance to the pool. When all instances are in use, callers wait for one
+// to be released, see [PoolDef] to fail instead. Idle instances are health checked along with other services,
+// all instances are shut down during shutdown. Pooled services cannot be invoked or injected directly.
+func ProvidePool[I any, T any](fn func(ctx context.Context) (T, error), size int) PoolDef {
+	return ProvideNamedPool[I](typetostring.GetType[I](), fn, size)
+}
+
 // ProvideNamedFactory0 is like ProvideFactory0 but allows to specify a name.
-func ProvideNamedFactory0[I any, T any](name string, fn func(ctx context.Context) (T, error)) ServiceDef {
+func ProvideNamedFactory0[I any, T any](name string, fn func(ctx context.Context) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 	return &ServiceFactory0[I, T]{
 		fn:             fn,
@@ -99,7 +214,7 @@ func ProvideNamedFactory0[I any, T any](name string, fn func(ctx context.Context
 }
 
 // ProvideNamedFactory1 is like ProvideFactory1 but allows to specify a name.
-func ProvideNamedFactory1[I any, T any, P1 any](name string, fn func(ctx context.Context, p1 P1) (T, error)) ServiceDef {
+func ProvideNamedFactory1[I any, T any, P1 any](name string, fn func(ctx context.Context, p1 P1) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 
 	return &ServiceFactory1[I, T, P1]{
@@ -109,7 +224,7 @@ func ProvideNamedFactory1[I any, T any, P1 any](name string, fn func(ctx context
 }
 
 // ProvideNamedFactory2 is like ProvideFactory2 but allows to specify a name.
-func ProvideNamedFactory2[I any, T any, P1 any, P2 any](name string, fn func(ctx context.Context, p1 P1, p2 P2) (T, error)) ServiceDef {
+func ProvideNamedFactory2[I any, T any, P1 any, P2 any](name string, fn func(ctx context.Context, p1 P1, p2 P2) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 
 	return &ServiceFactory2[I, T, P1, P2]{
@@ -119,7 +234,7 @@ func ProvideNamedFactory2[I any, T any, P1 any, P2 any](name string, fn func(ctx
 }
 
 // ProvideNamedFactory3 is like ProvideFactory3 but allows to specify a name.
-func ProvideNamedFactory3[I any, T any, P1 any, P2 any, P3 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3) (T, error)) ServiceDef {
+func ProvideNamedFactory3[I any, T any, P1 any, P2 any, P3 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 
 	return &ServiceFactory3[I, T, P1, P2, P3]{
@@ -129,7 +244,7 @@ func ProvideNamedFactory3[I any, T any, P1 any, P2 any, P3 any](name string, fn
 }
 
 // ProvideNamedFactory4 is like ProvideFactory4 but allows to specify a name.
-func ProvideNamedFactory4[I any, T any, P1 any, P2 any, P3 any, P4 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (T, error)) ServiceDef {
+func ProvideNamedFactory4[I any, T any, P1 any, P2 any, P3 any, P4 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 
 	return &ServiceFactory4[I, T, P1, P2, P3, P4]{
@@ -139,7 +254,7 @@ func ProvideNamedFactory4[I any, T any, P1 any, P2 any, P3 any, P4 any](name str
 }
 
 // ProvideNamedFactory5 is like ProvideFactory5 but allows to specify a name.
-func ProvideNamedFactory5[I any, T any, P1 any, P2 any, P3 any, P4 any, P5 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error)) ServiceDef {
+func ProvideNamedFactory5[I any, T any, P1 any, P2 any, P3 any, P4 any, P5 any](name string, fn func(ctx context.Context, p1 P1, p2 P2, p3 P3, p4 P4, p5 P5) (T, error)) FactoryDef {
 	validateFactoryFunction[I, T](fn)
 
 	return &ServiceFactory5[I, T, P1, P2, P3, P4, P5]{
@@ -148,6 +263,45 @@ func ProvideNamedFactory5[I any, T any, P1 any, P2 any, P3 any, P4 any, P5 any](
 	}
 }
 
+// ProvideNamedFactoryArgs is like ProvideFactoryArgs but allows to specify a name.
+func ProvideNamedFactoryArgs[I any, T any, A any](name string, fn func(ctx context.Context, args A) (T, error)) FactoryDef {
+	validateFactoryFunction[I, T](fn)
+	validateStruct[A]("Factory arguments")
+
+	return &ServiceFactoryArgs[I, T, A]{
+		fn:             fn,
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: name}},
+	}
+}
+
+// ProvideNamedAssistedFactory is like ProvideAssistedFactory but allows to specify a name.
+func ProvideNamedAssistedFactory[I any, T any, A any, D any](name string, fn func(ctx context.Context, args A, deps D) (T, error)) FactoryDef {
+	validateFactoryFunction[I, T](fn)
+	validateStruct[D]("Factory dependencies")
+
+	return newServiceFactoryAssisted[I](name, fn)
+}
+
+// ProvideNamedKeyed is like ProvideKeyed but allows to specify a name.
+func ProvideNamedKeyed[I any, T any, K comparable](name string, fn func(ctx context.Context, key K) (T, error)) KeyedDef {
+	validateFactoryFunction[I, T](fn)
+
+	return &ServiceKeyed[I, T, K]{
+		fn:             fn,
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: name}},
+	}
+}
+
+// ProvideNamedPool is like ProvidePool but allows to specify a name.
+func ProvideNamedPool[I any, T any](name string, fn func(ctx context.Context) (T, error), size int) PoolDef {
+	validateFactoryFunction[I, T](fn)
+	if size < 1 {
+		panic(fmt.Sprintf("Pool size must be positive, got %d", size))
+	}
+
+	return newServicePool[I](name, size, fn)
+}
+
 // ProvidePal registers all services for the given pal instance
 func ProvidePal(pal *Pal) ServiceDef {
 	services := make([]ServiceDef, 0, len(pal.Services()))
@@ -201,6 +355,51 @@ func MustInvokeNamed[T any](ctx context.Context, invoker Invoker, name string, a
 	return must(InvokeNamed[T](ctx, invoker, name, args...))
 }
 
+// InvokeWith creates an instance of I with a factory registered with [ProvideFactoryArgs] passing a single struct
+// of arguments. The struct is checked against the arguments type of the factory at runtime, a mismatch results in
+// an error wrapping [ErrServiceInvalidArgumentType].
+// Invoker may be nil, in this case an instance of Pal will be extracted from the context,
+// if the context does not contain a Pal instance, an error will be returned.
+func InvokeWith[I any, A any](ctx context.Context, invoker Invoker, args A) (I, error) {
+	return InvokeNamed[I](ctx, invoker, typetostring.GetType[I](), args)
+}
+
+// InvokeNamedWith is like InvokeWith but allows to specify a name.
+func InvokeNamedWith[I any, A any](ctx context.Context, invoker Invoker, name string, args A) (I, error) {
+	return InvokeNamed[I](ctx, invoker, name, args)
+}
+
+// MustInvokeWith is like InvokeWith but panics if an error occurs.
+func MustInvokeWith[I any, A any](ctx context.Context, invoker Invoker, args A) I {
+	return must(InvokeWith[I](ctx, invoker, args))
+}
+
+// Release shuts down an instance created by a tracked factory and stops tracking it, see [FactoryDef].
+// Returns an error wrapping [ErrNotTracked] if the instance was not created by a tracked factory or is already released.
+// Invoker may be a Pal, a Container or a Scope, instances invoked within a scope are released by its Pal.
+// Invoker may be nil, in this case an instance of Pal will be extracted from the context,
+// if the context does not contain a Pal instance, an error will be returned.
+func Release(ctx context.Context, invoker Invoker, instance any) error {
+	if invoker == nil {
+		var err error
+		invoker, err = FromContext(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch invoker := invoker.(type) {
+	case *Pal:
+		return invoker.container.release(ctx, instance)
+	case *Container:
+		return invoker.release(ctx, instance)
+	case *Scope:
+		return invoker.pal.container.release(ctx, instance)
+	default:
+		return fmt.Errorf("%w: %T cannot release instances", ErrNotTracked, invoker)
+	}
+}
+
 // InvokeAs invokes a service and casts it to the expected type. It returns an error if the cast fails.
 // May be useful when invoking a service with an interface type and you want to cast it to a concrete type.
 // Invoker may be nil, in this case an instance of Pal will be extracted from the context,
@@ -310,6 +509,57 @@ func MustInjectInto[T any](ctx context.Context, invoker Invoker, s *T) {
 	must("", InjectInto(ctx, invoker, s))
 }
 
+// Call calls fn with its parameters resolved from the container and returns the error returned by fn, if any.
+// fn may return nothing or an error and must be neither nil nor variadic. Parameters are resolved with the same rules as [ProvideConstructor]:
+// context.Context parameters receive ctx, parameters of struct types are parameter objects whose fields are injected
+// like with [InjectInto], including factories and named lookups, other parameters are resolved by type and must be resolvable.
+// Invoker may be nil, in this case an instance of Pal will be extracted from the context.
+func Call(ctx context.Context, invoker Invoker, fn any) error {
+	fnType := reflect.TypeOf(fn)
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		return fmt.Errorf("%w: must be a function, got %T", ErrInvalidFunction, fn)
+	}
+
+	if fnType.NumOut() > 1 || (fnType.NumOut() == 1 && fnType.Out(0) != errorType) {
+		return fmt.Errorf("%w: must return nothing or error, got %s", ErrInvalidFunction, fnType)
+	}
+
+	if fnType.IsVariadic() {
+		return fmt.Errorf("%w: must not be variadic, got %s", ErrInvalidFunction, fnType)
+	}
+
+	if reflect.ValueOf(fn).IsNil() {
+		return fmt.Errorf("%w: must not be nil, got %s", ErrInvalidFunction, fnType)
+	}
+
+	if invoker == nil {
+		var err error
+		invoker, err = FromContext(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	args, err := newParameters(fnType).resolve(ctx, invoker)
+	if err != nil {
+		return err
+	}
+
+	results := reflect.ValueOf(fn).Call(args)
+
+	if len(results) == 0 {
+		return nil
+	}
+
+	err, _ = results[0].Interface().(error)
+	return err
+}
+
+// MustCall is like Call but panics if an error occurs.
+func MustCall(ctx context.Context, invoker Invoker, fn any) {
+	must("", Call(ctx, invoker, fn))
+}
+
 func must[T any](value T, err error) T {
 	if err != nil {
 		panic(err)
@@ -325,6 +575,50 @@ func validateNonNilPointer(value any) {
 	}
 }
 
+func validateGroupMember[I any, T any]() {
+	iType := reflect.TypeOf((*I)(nil)).Elem()
+	tType := reflect.TypeOf((*T)(nil)).Elem()
+
+	if !tType.AssignableTo(iType) {
+		panic(fmt.Sprintf("T (%s) must be assignable to I (%s)", tType, iType))
+	}
+}
+
+func groupMemberName[T any](group string) string {
+	return fmt.Sprintf("$group-%s-%s-%s", group, typetostring.GetType[T](), randomID())
+}
+
+func validateConstructor[I any](fn any) {
+	fnType := reflect.TypeOf(fn)
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		panic(fmt.Sprintf("Constructor must be a function, got %T", fn))
+	}
+
+	if fnType.NumIn() == 0 || fnType.In(0) != contextType {
+		panic(fmt.Sprintf("Constructor must accept context.Context as the first argument, got %s", fnType))
+	}
+
+	if fnType.NumOut() != 2 || fnType.Out(1) != errorType {
+		panic(fmt.Sprintf("Constructor must return (T, error), got %s", fnType))
+	}
+
+	tType := fnType.Out(0)
+	if tType.Kind() != reflect.Pointer {
+		panic(fmt.Sprintf("Constructor must return a pointer, got %s", tType.Kind()))
+	}
+
+	iType := reflect.TypeOf((*I)(nil)).Elem()
+	if tType != iType && (iType.Kind() != reflect.Interface || !tType.Implements(iType)) {
+		panic(fmt.Sprintf("T (%s) must implement interface I (%s)", tType, iType))
+	}
+}
+
+func validateStruct[S any](what string) {
+	if sType := reflect.TypeOf((*S)(nil)).Elem(); sType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("%s must be a struct, got %s", what, sType))
+	}
+}
+
 func validateFactoryFunction[I any, T any](fn any) {
 	// Factory function must return a pointer to a struct that implements I
 	// I and T must be the same pointer type.
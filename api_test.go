@@ -83,6 +83,453 @@ func TestProvideFactory0(t *testing.T) {
 	})
 }
 
+type groupRoute interface {
+	Path() string
+}
+
+type groupRouteA struct{ initialized bool }
+
+func (r *groupRouteA) Init(_ context.Context) error {
+	r.initialized = true
+	return nil
+}
+
+func (r *groupRouteA) Path() string { return "/a" }
+
+type groupRouteB struct{ path string }
+
+func (r *groupRouteB) Path() string { return r.path }
+
+type groupConsumer struct {
+	Routes []groupRoute `pal:"group=routes"`
+
+	membersInitialized bool
+}
+
+func (c *groupConsumer) Init(_ context.Context) error {
+	c.membersInitialized = c.Routes[0].(*groupRouteA).initialized
+	return nil
+}
+
+type invalidGroupConsumer struct {
+	Routes groupRoute `pal:"group=routes"`
+}
+
+type otherGroupConsumer struct {
+	Routes []groupRoute `pal:"group=other"`
+}
+
+// TestProvideToGroup tests the ProvideToGroup function
+func TestProvideToGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("injects all members of the group in registration order", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &groupConsumer{}
+
+		p := newPal(
+			pal.Provide(consumer),
+			pal.ProvideToGroup[groupRoute]("routes", &groupRouteA{}),
+			pal.ProvideToGroup[groupRoute]("routes", &groupRouteB{path: "/b"}),
+			pal.ProvideToGroup[groupRoute]("routes", &groupRouteB{path: "/c"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		paths := []string{}
+		for _, route := range consumer.Routes {
+			paths = append(paths, route.Path())
+		}
+
+		assert.Equal(t, []string{"/a", "/b", "/c"}, paths)
+		assert.True(t, consumer.membersInitialized)
+	})
+
+	t.Run("injects an empty slice for an empty group", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &otherGroupConsumer{}
+
+		p := newPal(
+			pal.Provide(consumer),
+			pal.ProvideToGroup[groupRoute]("routes", &groupRouteB{path: "/b"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.NotNil(t, consumer.Routes)
+		assert.Empty(t, consumer.Routes)
+	})
+
+	t.Run("returns error when the field is not a slice", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&invalidGroupConsumer{}),
+			pal.ProvideToGroup[groupRoute]("routes", &groupRouteB{path: "/b"}),
+		)
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrInvalidTag)
+	})
+
+	t.Run("panics when the value does not implement the interface", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() {
+			pal.ProvideToGroup[groupRoute]("routes", &groupConsumer{})
+		})
+	})
+}
+
+// TestProvideFnToGroup tests the ProvideFnToGroup function
+func TestProvideFnToGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("initializes members built with functions before the consumer", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &groupConsumer{}
+
+		p := newPal(
+			pal.Provide(consumer),
+			pal.ProvideFnToGroup[groupRoute]("routes", func(_ context.Context) (*groupRouteA, error) {
+				return &groupRouteA{}, nil
+			}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Len(t, consumer.Routes, 1)
+		assert.True(t, consumer.membersInitialized)
+	})
+}
+
+type decoratorMetrics struct{ initialized bool }
+
+func (m *decoratorMetrics) Init(_ context.Context) error {
+	m.initialized = true
+	return nil
+}
+
+type cachingStorage struct {
+	Inner   storageBackend
+	Metrics *decoratorMetrics
+}
+
+func (s *cachingStorage) Backend() string { return "cached-" + s.Inner.Backend() }
+
+type prefixedStorage struct {
+	inner  storageBackend
+	prefix string
+}
+
+func (s *prefixedStorage) Backend() string { return s.prefix + s.inner.Backend() }
+
+func prefixStorage(prefix string) func(_ context.Context, inner storageBackend) (storageBackend, error) {
+	return func(_ context.Context, inner storageBackend) (storageBackend, error) {
+		return &prefixedStorage{inner: inner, prefix: prefix}, nil
+	}
+}
+
+type storageConsumer struct {
+	Storage storageBackend
+}
+
+// TestDecorate tests the Decorate function
+func TestDecorate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("dependents receive the decorated instance", func(t *testing.T) {
+		t.Parallel()
+
+		storage := &tenantStorage{backend: "s3"}
+		consumer := &storageConsumer{}
+		metrics := &decoratorMetrics{}
+
+		p := newPal(
+			pal.Provide(consumer),
+			pal.Decorate[storageBackend](func(_ context.Context, inner storageBackend) (*cachingStorage, error) {
+				return &cachingStorage{Inner: inner}, nil
+			}),
+			pal.Provide[storageBackend](storage),
+			pal.Provide(metrics),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		decorated, ok := consumer.Storage.(*cachingStorage)
+		require.True(t, ok)
+
+		assert.Equal(t, "cached-s3", consumer.Storage.Backend())
+		assert.Same(t, storage, decorated.Inner)
+		assert.Same(t, metrics, decorated.Metrics)
+		assert.True(t, storage.initialized)
+		assert.Same(t, decorated, pal.MustInvoke[storageBackend](t.Context(), p))
+	})
+
+	t.Run("applies decorators in registration order", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &storageConsumer{}
+
+		p := newPal(
+			pal.Decorate[storageBackend](prefixStorage("a-")),
+			pal.Provide[storageBackend](&tenantStorage{backend: "s3"}),
+			pal.Provide(consumer),
+			pal.Decorate[storageBackend](prefixStorage("b-")),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Equal(t, "b-a-s3", consumer.Storage.Backend())
+	})
+
+	t.Run("returns error when the decorated service is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Decorate[storageBackend](prefixStorage("a-")))
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+
+	t.Run("returns error when the decorated service is not a singleton", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.ProvideFactory0[storageBackend](func(_ context.Context) (*tenantStorage, error) {
+				return &tenantStorage{}, nil
+			}),
+			pal.Decorate[storageBackend](prefixStorage("a-")),
+		)
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrServiceInvalid)
+	})
+
+	t.Run("returns error when the decorator fails", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide[storageBackend](&tenantStorage{backend: "s3"}),
+			pal.Decorate[storageBackend](func(_ context.Context, _ storageBackend) (storageBackend, error) {
+				return nil, errTest
+			}),
+		)
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, errTest)
+	})
+}
+
+type constructedLogger struct{ initialized bool }
+
+func (l *constructedLogger) Init(_ context.Context) error {
+	l.initialized = true
+	return nil
+}
+
+type constructedRepo struct{ name string }
+
+type constructedParams struct {
+	Repo *constructedRepo `pal:"name=primary"`
+}
+
+type constructedService struct {
+	logger *constructedLogger
+	repo   *constructedRepo
+
+	loggerInitialized bool
+}
+
+func newConstructedService(_ context.Context, logger *constructedLogger, params constructedParams) (*constructedService, error) {
+	return &constructedService{logger: logger, repo: params.Repo, loggerInitialized: logger.initialized}, nil
+}
+
+// TestProvideConstructor tests the ProvideConstructor function
+func TestProvideConstructor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resolves parameters by type and through parameter objects", func(t *testing.T) {
+		t.Parallel()
+
+		logger := &constructedLogger{}
+		repo := &constructedRepo{name: "primary"}
+
+		p := newPal(
+			pal.ProvideConstructor[*constructedService](newConstructedService),
+			pal.Provide(logger),
+			pal.ProvideNamed("primary", repo),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		service := pal.MustInvoke[*constructedService](t.Context(), p)
+
+		assert.Same(t, logger, service.logger)
+		assert.Same(t, repo, service.repo)
+		assert.True(t, service.loggerInitialized)
+	})
+
+	t.Run("registers the service as an interface", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideConstructor[Pinger](func(_ context.Context) (*Pinger1, error) {
+			return &Pinger1{}, nil
+		}))
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.IsType(t, &Pinger1{}, pal.MustInvoke[Pinger](t.Context(), p))
+	})
+
+	t.Run("returns error when a parameter cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.ProvideConstructor[*constructedService](newConstructedService),
+			pal.ProvideNamed("primary", &constructedRepo{}),
+		)
+
+		_, err := p.Validate(t.Context())
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+
+		err = p.Init(t.Context())
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+
+	t.Run("returns error when the constructor fails", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideConstructor[*Pinger1](func(_ context.Context) (*Pinger1, error) {
+			return nil, errTest
+		}))
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, errTest)
+	})
+
+	t.Run("panics when the constructor is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() { pal.ProvideConstructor[*Pinger1](&Pinger1{}) })
+		assert.Panics(t, func() { pal.ProvideConstructor[*Pinger1](func() (*Pinger1, error) { return nil, nil }) })
+		assert.Panics(t, func() { pal.ProvideConstructor[*Pinger1](func(_ context.Context) *Pinger1 { return nil }) })
+		assert.Panics(t, func() { pal.ProvideConstructor[Pinger](func(_ context.Context) (*Pinger1, int) { return nil, 0 }) })
+		assert.Panics(t, func() {
+			pal.ProvideConstructor[Pinger](func(_ context.Context) (*constructedRepo, error) { return nil, nil })
+		})
+	})
+}
+
+type callParams struct {
+	Repo          *constructedRepo `pal:"name=primary"`
+	CreateService func(ctx context.Context, name string) (*factory1Service, error)
+}
+
+// TestCall tests the Call function
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	newCallPal := func(t *testing.T) *pal.Pal {
+		t.Helper()
+
+		p := newPal(
+			pal.Provide(&constructedLogger{}),
+			pal.ProvideNamed("primary", &constructedRepo{name: "primary"}),
+			pal.ProvideFactory1[*factory1Service](func(_ context.Context, name string) (*factory1Service, error) {
+				return &factory1Service{Name: name}, nil
+			}),
+		)
+		require.NoError(t, p.Init(t.Context()))
+
+		return p
+	}
+
+	t.Run("calls the function with resolved parameters", func(t *testing.T) {
+		t.Parallel()
+
+		p := newCallPal(t)
+
+		var (
+			logger  *constructedLogger
+			repo    *constructedRepo
+			service *factory1Service
+		)
+
+		err := pal.Call(t.Context(), p, func(ctx context.Context, l *constructedLogger, params callParams) error {
+			logger = l
+			repo = params.Repo
+
+			var err error
+			service, err = params.CreateService(ctx, "created")
+			return err
+		})
+
+		require.NoError(t, err)
+		assert.Same(t, pal.MustInvoke[*constructedLogger](t.Context(), p), logger)
+		assert.Equal(t, "primary", repo.name)
+		assert.Equal(t, "created", service.Name)
+	})
+
+	t.Run("returns the error returned by the function", func(t *testing.T) {
+		t.Parallel()
+
+		p := newCallPal(t)
+
+		err := pal.Call(t.Context(), p, func(_ *constructedLogger) error {
+			return errTest
+		})
+
+		assert.ErrorIs(t, err, errTest)
+	})
+
+	t.Run("uses pal from context when invoker is nil", func(t *testing.T) {
+		t.Parallel()
+
+		p := newCallPal(t)
+
+		called := false
+		pal.MustCall(pal.WithPal(t.Context(), p), nil, func(_ *constructedLogger) {
+			called = true
+		})
+
+		assert.True(t, called)
+	})
+
+	t.Run("returns error when a parameter cannot be resolved", func(t *testing.T) {
+		t.Parallel()
+
+		p := newCallPal(t)
+
+		err := pal.Call(t.Context(), p, func(_ context.Context, _ *Pinger1) error {
+			t.Fatal("must not be called")
+			return nil
+		})
+
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+		assert.ErrorContains(t, err, "parameter 1")
+	})
+
+	t.Run("returns error when fn is not a supported function", func(t *testing.T) {
+		t.Parallel()
+
+		p := newCallPal(t)
+
+		assert.ErrorIs(t, pal.Call(t.Context(), p, &Pinger1{}), pal.ErrInvalidFunction)
+		assert.ErrorIs(t, pal.Call(t.Context(), p, func() int { return 0 }), pal.ErrInvalidFunction)
+		assert.ErrorIs(t, pal.Call(t.Context(), p, func(_ context.Context, _ ...*Pinger1) {}), pal.ErrInvalidFunction)
+		assert.ErrorIs(t, pal.Call(t.Context(), p, (func(_ context.Context))(nil)), pal.ErrInvalidFunction)
+	})
+}
+
 // TestProvideNamed tests the ProvideNamed function
 func TestProvideNamed(t *testing.T) {
 	t.Parallel()
@@ -312,10 +759,131 @@ func TestBuild(t *testing.T) {
 	})
 }
 
+type storageBackend interface {
+	Backend() string
+}
+
+type tenantStorage struct {
+	backend     string
+	initialized bool
+}
+
+func (s *tenantStorage) Init(_ context.Context) error {
+	s.initialized = true
+	return nil
+}
+
+func (s *tenantStorage) Backend() string { return s.backend }
+
+type storageRouter struct {
+	Backends map[string]storageBackend `pal:"names"`
+
+	allInitialized bool
+}
+
+func (r *storageRouter) Init(_ context.Context) error {
+	r.allInitialized = true
+	for _, backend := range r.Backends {
+		r.allInitialized = r.allInitialized && backend.(*tenantStorage).initialized
+	}
+	return nil
+}
+
+type untaggedStorageRouter struct {
+	Backends map[string]storageBackend
+}
+
+type filteredStorageRouter struct {
+	Backends map[string]storageBackend `pal:"names=tenant-eu-*"`
+}
+
+type invalidStorageRouter struct {
+	Backends []storageBackend `pal:"names=tenant-*"`
+}
+
 // TestInjectInto tests the InjectInto function
 func TestInjectInto(t *testing.T) {
 	t.Parallel()
 
+	t.Run("injects named services into a map and initializes them first", func(t *testing.T) {
+		t.Parallel()
+
+		router := &storageRouter{}
+
+		p := newPal(
+			pal.Provide(router),
+			pal.ProvideNamed("tenant-eu-1", &tenantStorage{backend: "s3"}),
+			pal.ProvideNamed("tenant-us-1", &tenantStorage{backend: "gcs"}),
+			pal.ProvideToGroup[storageBackend]("backends", &tenantStorage{backend: "grouped"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Len(t, router.Backends, 2)
+		assert.Equal(t, "s3", router.Backends["tenant-eu-1"].Backend())
+		assert.Equal(t, "gcs", router.Backends["tenant-us-1"].Backend())
+		assert.True(t, router.allInitialized)
+	})
+
+	t.Run("filters services injected into a map by name pattern", func(t *testing.T) {
+		t.Parallel()
+
+		router := &filteredStorageRouter{}
+
+		p := newPal(
+			pal.Provide(router),
+			pal.ProvideNamed("tenant-eu-1", &tenantStorage{backend: "s3"}),
+			pal.ProvideNamed("tenant-eu-2", &tenantStorage{backend: "minio"}),
+			pal.ProvideNamed("tenant-us-1", &tenantStorage{backend: "gcs"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Len(t, router.Backends, 2)
+		assert.Contains(t, router.Backends, "tenant-eu-1")
+		assert.Contains(t, router.Backends, "tenant-eu-2")
+	})
+
+	t.Run("injects only services registered with explicit names into a map", func(t *testing.T) {
+		t.Parallel()
+
+		router := &storageRouter{}
+
+		p := newPal(
+			pal.Provide(router),
+			pal.ProvideNamed("tenant-eu-1", &tenantStorage{backend: "s3"}),
+			pal.Provide[storageBackend](&tenantStorage{backend: "unnamed"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Len(t, router.Backends, 1)
+		assert.Contains(t, router.Backends, "tenant-eu-1")
+	})
+
+	t.Run("does not inject maps without names tag", func(t *testing.T) {
+		t.Parallel()
+
+		router := &untaggedStorageRouter{}
+
+		p := newPal(
+			pal.Provide(router),
+			pal.ProvideNamed("tenant-eu-1", &tenantStorage{backend: "s3"}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Nil(t, router.Backends)
+	})
+
+	t.Run("returns error when names tag is used on a non-map field", func(t *testing.T) {
+		t.Parallel()
+
+		err := pal.InjectInto(t.Context(), newPal(), &invalidStorageRouter{})
+
+		assert.ErrorIs(t, err, pal.ErrInvalidTag)
+	})
+
 	t.Run("injects dependencies successfully", func(t *testing.T) {
 		t.Parallel()
 
@@ -434,6 +1002,29 @@ func TestInjectInto(t *testing.T) {
 		assert.Equal(t, instance.Pinger, pinger)
 	})
 
+	t.Run("does not match group members by interface", func(t *testing.T) {
+		t.Parallel()
+
+		type StructWithSkipField struct {
+			Pinger Pinger `pal:"match_interface"`
+		}
+
+		pinger := &Pinger1{}
+
+		p := newPal(
+			pal.Provide(pinger),
+			pal.ProvideToGroup[Pinger]("pingers", &Pinger2{}),
+			pal.ProvideToGroup[Pinger]("pingers", &Pinger2{}),
+		)
+
+		instance := &StructWithSkipField{}
+
+		err := pal.InjectInto(t.Context(), p, instance)
+
+		assert.NoError(t, err)
+		assert.Same(t, pinger, instance.Pinger)
+	})
+
 	t.Run("returns an error if service that should be matched by interface is not provided", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,272 @@
+// Package analysis provides an analyzer reporting misuses of pal that are otherwise detected only at runtime,
+// or never. It can be run with `pal lint`, with `go vet -vettool=$(which palvet)` or with any go/analysis driver.
+package analysis
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/zhulik/pal"
+)
+
+const palPath = "github.com/zhulik/pal"
+
+// Analyzer reports:
+//   - type arguments of Provide* and Decorate* functions that fail pal's runtime checks: I that is not an interface
+//     while it differs from T, T that does not implement I, and non-pointer values passed to Provide;
+//   - unknown and malformed `pal` struct tags;
+//   - factories invoked manually inside Init methods and ToInit hooks, pal does not know about such dependencies;
+//   - ToInit, ToShutdown and ToHealthCheck hooks registered for types having the matching lifecycle method,
+//     the method is never called as hooks take precedence.
+var Analyzer = &analysis.Analyzer{
+	Name:     "pal",
+	Doc:      "report misuses of the pal dependency injection toolkit",
+	URL:      "https://pkg.go.dev/github.com/zhulik/pal/cmd/pal/analysis",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// hookMethods maps hook registration methods to lifecycle methods they replace.
+var hookMethods = map[string][]string{
+	"ToInit":        {"Init", "PalInit"},
+	"ToShutdown":    {"Shutdown", "PalShutdown"},
+	"ToHealthCheck": {"HealthCheck", "PalHealthCheck"},
+}
+
+// initMethods are methods called by pal during initialization.
+var initMethods = map[string]bool{"Init": true, "PalInit": true}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	factories := []types.Type{}
+
+	insp.Preorder([]ast.Node{(*ast.StructType)(nil), (*ast.CallExpr)(nil)}, func(node ast.Node) {
+		switch node := node.(type) {
+		case *ast.StructType:
+			checkTags(pass, node)
+		case *ast.CallExpr:
+			fn, ident := palFunc(pass.TypesInfo, node)
+			if fn == nil {
+				return
+			}
+			if factory := checkProvide(pass, node, fn, ident); factory != nil {
+				factories = append(factories, factory)
+			}
+			checkHook(pass, node, fn)
+		}
+	})
+
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(node ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := node.(*ast.CallExpr)
+		if fn, ident := palFunc(pass.TypesInfo, call); fn != nil && inInit(pass.TypesInfo, stack) {
+			checkInvoke(pass, call, fn, ident, factories)
+		}
+		return true
+	})
+
+	return nil, nil
+}
+
+// checkTags reports unknown and malformed pal tags of the struct fields.
+func checkTags(pass *analysis.Pass, st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		value, ok := reflect.StructTag(tag).Lookup("pal")
+		if !ok {
+			continue
+		}
+
+		value = strings.ReplaceAll(value, " ", "")
+		if value == "" {
+			continue
+		}
+
+		for part := range strings.SplitSeq(value, ",") {
+			parts := strings.Split(part, "=")
+			switch {
+			case !pal.Tag(parts[0]).Supported():
+				pass.Reportf(field.Tag.Pos(), "unknown pal tag %q", parts[0])
+			case len(parts) > 2 || (len(parts) == 2 && parts[1] == ""):
+				pass.Reportf(field.Tag.Pos(), "malformed pal tag %q", part)
+			}
+		}
+	}
+}
+
+// checkProvide reports type arguments of Provide* and Decorate* calls failing pal's runtime checks.
+// It returns the type factories registered by the call are invoked by, if any.
+func checkProvide(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, ident *ast.Ident) types.Type {
+	if !strings.HasPrefix(fn.Name(), "Provide") && !strings.HasPrefix(fn.Name(), "Decorate") {
+		return nil
+	}
+
+	args := typeArgs(pass.TypesInfo, fn, ident)
+	iType, hasI := args["I"]
+	tType, hasT := args["T"]
+
+	if strings.HasSuffix(fn.Name(), "Constructor") && len(call.Args) > 0 {
+		if sig, ok := pass.TypesInfo.TypeOf(call.Args[len(call.Args)-1]).(*types.Signature); ok && sig.Results().Len() > 0 {
+			tType, hasT = sig.Results().At(0).Type(), true
+		}
+	}
+
+	switch {
+	case isTypeParam(iType) || isTypeParam(tType):
+		// Checked when the enclosing generic function is instantiated.
+	case hasI && hasT && !types.Identical(iType, tType):
+		if !types.IsInterface(iType) {
+			pass.Reportf(call.Pos(), "%s: I (%s) must be an interface when it differs from T (%s)", fn.Name(), iType, tType)
+		} else if !types.AssignableTo(tType, iType) {
+			pass.Reportf(call.Pos(), "%s: T (%s) does not implement I (%s)", fn.Name(), tType, iType)
+		}
+	case hasT && !hasI && fn.Name() != "ProvidePal":
+		if _, ok := tType.Underlying().(*types.Pointer); !ok && !types.IsInterface(tType) {
+			pass.Reportf(call.Pos(), "%s: value must be a pointer, got %s", fn.Name(), tType)
+		}
+	}
+
+	if strings.Contains(fn.Name(), "Factory") && hasI {
+		return iType
+	}
+	return nil
+}
+
+// checkHook reports lifecycle hooks registered for types having the lifecycle method the hook replaces.
+func checkHook(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) {
+	methods, ok := hookMethods[fn.Name()]
+	if !ok || fn.Signature().Recv() == nil {
+		return
+	}
+
+	sig, ok := pass.TypesInfo.TypeOf(call.Fun).(*types.Signature)
+	if !ok || sig.Params().Len() != 1 {
+		return
+	}
+	hook, ok := sig.Params().At(0).Type().Underlying().(*types.Signature)
+	if !ok || hook.Params().Len() < 2 {
+		return
+	}
+	service := hook.Params().At(1).Type()
+
+	for _, name := range methods {
+		if obj, _, _ := types.LookupFieldOrMethod(service, true, nil, name); obj != nil {
+			if _, isMethod := obj.(*types.Func); isMethod {
+				pass.Reportf(call.Pos(), "%s hook replaces the %s method of %s, the method is never called", fn.Name(), name, service)
+				return
+			}
+		}
+	}
+}
+
+// checkInvoke reports factories invoked manually, the call is known to be made during initialization.
+func checkInvoke(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func, ident *ast.Ident, factories []types.Type) {
+	name := strings.TrimPrefix(fn.Name(), "Must")
+	if !strings.HasPrefix(name, "Invoke") {
+		return
+	}
+
+	sig := fn.Signature()
+	if strings.HasSuffix(name, "With") || sig.Variadic() && (len(call.Args) >= sig.Params().Len() || call.Ellipsis.IsValid()) {
+		pass.Reportf(call.Pos(), "%s with arguments invokes a factory during initialization, inject a factory function instead", fn.Name())
+		return
+	}
+
+	if t, ok := typeArgs(pass.TypesInfo, fn, ident)["T"]; ok {
+		for _, factory := range factories {
+			if types.Identical(t, factory) {
+				pass.Reportf(call.Pos(), "%s invokes factory %s during initialization, inject a factory function instead", fn.Name(), t)
+				return
+			}
+		}
+	}
+}
+
+// inInit reports whether the innermost function of the stack is an Init method or a ToInit hook.
+func inInit(info *types.Info, stack []ast.Node) bool {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch node := stack[i].(type) {
+		case *ast.FuncDecl:
+			return node.Recv != nil && initMethods[node.Name.Name]
+		case *ast.FuncLit:
+			if i == 0 {
+				return false
+			}
+			call, ok := stack[i-1].(*ast.CallExpr)
+			if !ok {
+				return false
+			}
+			fn, _ := palFunc(info, call)
+			return fn != nil && fn.Name() == "ToInit"
+		}
+	}
+	return false
+}
+
+// palFunc returns the pal function or method called, and the identifier it is referenced by.
+func palFunc(info *types.Info, call *ast.CallExpr) (*types.Func, *ast.Ident) {
+	ident := calleeIdent(call.Fun)
+	if ident == nil {
+		return nil, nil
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != palPath {
+		return nil, nil
+	}
+
+	return fn, ident
+}
+
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch expr := fun.(type) {
+	case *ast.Ident:
+		return expr
+	case *ast.SelectorExpr:
+		return expr.Sel
+	case *ast.IndexExpr:
+		return calleeIdent(expr.X)
+	case *ast.IndexListExpr:
+		return calleeIdent(expr.X)
+	default:
+		return nil
+	}
+}
+
+// typeArgs returns the type arguments of the generic function call keyed by type parameter names.
+func typeArgs(info *types.Info, fn *types.Func, ident *ast.Ident) map[string]types.Type {
+	args := map[string]types.Type{}
+
+	instance, ok := info.Instances[ident]
+	if !ok {
+		return args
+	}
+
+	params := fn.Signature().TypeParams()
+	for i := 0; i < params.Len() && i < instance.TypeArgs.Len(); i++ {
+		args[params.At(i).Obj().Name()] = instance.TypeArgs.At(i)
+	}
+
+	return args
+}
+
+func isTypeParam(t types.Type) bool {
+	_, ok := t.(*types.TypeParam)
+	return ok
+}
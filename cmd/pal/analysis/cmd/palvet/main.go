@@ -0,0 +1,12 @@
+// Command palvet runs the pal analyzer, standalone or as `go vet -vettool=$(which palvet) ./...`.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/zhulik/pal/cmd/pal/analysis"
+)
+
+func main() {
+	singlechecker.Main(analysis.Analyzer)
+}
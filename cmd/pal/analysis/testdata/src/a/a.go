@@ -0,0 +1,68 @@
+package a
+
+import "github.com/zhulik/pal"
+
+type Pinger interface {
+	Ping()
+}
+
+type pinger struct{}
+
+func (p *pinger) Ping() {}
+
+type server struct{}
+
+func (s *server) Init(_ pal.Context) error { return nil }
+
+func (s *server) Shutdown(_ pal.Context) error { return nil }
+
+type client struct{}
+
+func newPinger(_ pal.Context) (*pinger, error) { return &pinger{}, nil }
+
+func newServer(_ pal.Context) (*server, error) { return &server{}, nil }
+
+type tagged struct {
+	Pinger  Pinger `pal:"name=pinger,optional"`
+	Unknown Pinger `pal:"nmae=pinger"` // want `unknown pal tag "nmae"`
+	Empty   Pinger `pal:"name="`       // want `malformed pal tag "name="`
+	Ignored Pinger `json:"ignored"`
+}
+
+type consumer struct {
+	invoker pal.Invoker
+}
+
+func (c *consumer) Init(ctx pal.Context) error {
+	_, _ = pal.Invoke[*client](ctx, c.invoker, "url") // want `Invoke with arguments invokes a factory during initialization`
+	_, _ = c.invoker.Invoke(ctx, "client", "url")     // want `Invoke with arguments invokes a factory during initialization`
+	_, _ = pal.Invoke[*client](ctx, c.invoker)        // want `Invoke invokes factory \*a.client during initialization`
+	_, _ = pal.Invoke[Pinger](ctx, c.invoker)
+	_, _ = pal.InvokeWith[*client](ctx, c.invoker, struct{ URL string }{}) // want `InvokeWith with arguments invokes a factory during initialization`
+	return nil
+}
+
+func (c *consumer) Run(ctx pal.Context) error {
+	_, err := pal.Invoke[*client](ctx, c.invoker, "url")
+	return err
+}
+
+func services() []pal.ServiceDef {
+	return []pal.ServiceDef{
+		pal.Provide[Pinger](&pinger{}),
+		pal.Provide(pinger{}), // want `Provide: value must be a pointer, got a.pinger`
+		pal.ProvideFn[Pinger](newPinger),
+		pal.ProvideFn[*pinger](newServer), // want `ProvideFn: I \(\*a.pinger\) must be an interface when it differs from T \(\*a.server\)`
+		pal.ProvideFn[Pinger](newServer),  // want `ProvideFn: T \(\*a.server\) does not implement I \(a.Pinger\)`
+		pal.ProvideConstructor[Pinger](newPinger),
+		pal.ProvideConstructor[Pinger](newServer), // want `ProvideConstructor: T \(\*a.server\) does not implement I \(a.Pinger\)`
+		pal.ProvideFactory1[*client](func(_ pal.Context, _ string) (*client, error) { return &client{}, nil }),
+		pal.Provide(&server{}).ToInit(func(_ pal.Context, _ *server, _ pal.Invoker) error { // want `ToInit hook replaces the Init method of \*a.server`
+			return nil
+		}),
+		pal.Provide(&pinger{}).ToInit(func(ctx pal.Context, _ *pinger, invoker pal.Invoker) error {
+			_, err := pal.Invoke[*client](ctx, invoker, "url") // want `Invoke with arguments invokes a factory during initialization`
+			return err
+		}),
+	}
+}
@@ -0,0 +1,39 @@
+// Package pal is a stub of the parts of pal used by the analyzer tests.
+package pal
+
+// Context stands in for Context, type checking the standard library from source slows the tests down.
+type Context = any
+
+type ServiceDef interface{}
+
+type Invoker interface {
+	Invoke(ctx Context, name string, args ...any) (any, error)
+}
+
+type LifecycleHook[T any] func(ctx Context, service T, invoker Invoker) error
+
+type Hookable[T any] interface {
+	ServiceDef
+	ToInit(hook LifecycleHook[T]) Hookable[T]
+	ToShutdown(hook LifecycleHook[T]) Hookable[T]
+}
+
+func Provide[T any](value T) Hookable[T] { return nil }
+
+func ProvideFn[I any, T any](fn func(ctx Context) (T, error)) Hookable[T] { return nil }
+
+func ProvideConstructor[I any](fn any) ServiceDef { return nil }
+
+func ProvideFactory1[I any, T any, P1 any](fn func(ctx Context, p1 P1) (T, error)) ServiceDef {
+	return nil
+}
+
+func Invoke[T any](ctx Context, invoker Invoker, args ...any) (T, error) {
+	var t T
+	return t, nil
+}
+
+func InvokeWith[I any, A any](ctx Context, invoker Invoker, args A) (I, error) {
+	var i I
+	return i, nil
+}
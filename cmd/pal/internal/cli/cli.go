@@ -3,7 +3,9 @@ package cli
 import (
 	"github.com/urfave/cli/v3"
 
+	gencmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/gen"
 	initcmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/init"
+	lintcmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/lint"
 	versioncmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/version"
 	"github.com/zhulik/pal/cmd/pal/internal/version"
 )
@@ -21,6 +23,8 @@ func New() *cli.Command {
 		Version: version.String(),
 		Commands: []*cli.Command{
 			initcmd.New(),
+			gencmd.New(),
+			lintcmd.New(),
 			versioncmd.New(),
 		},
 	}
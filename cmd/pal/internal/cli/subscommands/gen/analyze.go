@@ -0,0 +1,272 @@
+package gencmd
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const palPath = "github.com/zhulik/pal"
+
+// FieldKind is the way a field is injected by a generated injector.
+type FieldKind int
+
+const (
+	// FieldService fields are resolved to services.
+	FieldService FieldKind = iota
+	// FieldFactory fields are resolved to factory functions.
+	FieldFactory
+	// FieldLogger fields receive a logger.
+	FieldLogger
+)
+
+// Field is a field assigned by a generated injector.
+type Field struct {
+	Name string
+	Kind FieldKind
+	Type types.Type
+	// Dependency is the value of the name tag, empty for untagged fields.
+	Dependency string
+	Optional   bool
+}
+
+// Service is a struct a generated injector is created for.
+type Service struct {
+	Type   *types.Named
+	Fields []Field
+}
+
+// Analyze finds structs of the package that are registered with pal.Provide* and pal.Decorate* functions and
+// returns the ones having fields to inject, all of which can be injected without reflection, sorted by name.
+// Structs with fields needing Lazy, group, names, match_interface or config injection are left to reflection.
+func Analyze(pkg *packages.Package) []Service {
+	candidates := map[*types.Named]bool{}
+
+	for _, file := range pkg.Syntax {
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			for _, typ := range registeredTypes(pkg.TypesInfo, call) {
+				if named := localStruct(pkg.Types, typ); named != nil {
+					candidates[named] = true
+				}
+			}
+			return true
+		})
+	}
+
+	services := []Service{}
+	for named := range candidates {
+		if fields, ok := injectableFields(pkg.Types, named.Underlying().(*types.Struct)); ok && len(fields) > 0 {
+			services = append(services, Service{Type: named, Fields: fields})
+		}
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].Type.Obj().Name() < services[j].Type.Obj().Name()
+	})
+
+	return services
+}
+
+// registeredTypes returns the type arguments of a call of a pal.Provide* or pal.Decorate* function,
+// and the result type of constructors passed to them.
+func registeredTypes(info *types.Info, call *ast.CallExpr) []types.Type {
+	ident := calleeIdent(call.Fun)
+	if ident == nil {
+		return nil
+	}
+
+	fn, ok := info.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != palPath {
+		return nil
+	}
+	if !strings.HasPrefix(fn.Name(), "Provide") && !strings.HasPrefix(fn.Name(), "Decorate") {
+		return nil
+	}
+
+	registered := []types.Type{}
+
+	if instance, ok := info.Instances[ident]; ok {
+		for i := 0; i < instance.TypeArgs.Len(); i++ {
+			registered = append(registered, instance.TypeArgs.At(i))
+		}
+	}
+
+	for _, arg := range call.Args {
+		if sig, ok := info.TypeOf(arg).(*types.Signature); ok && sig.Results().Len() > 0 {
+			registered = append(registered, sig.Results().At(0).Type())
+		}
+	}
+
+	return registered
+}
+
+func calleeIdent(fun ast.Expr) *ast.Ident {
+	switch expr := fun.(type) {
+	case *ast.Ident:
+		return expr
+	case *ast.SelectorExpr:
+		return expr.Sel
+	case *ast.IndexExpr:
+		return calleeIdent(expr.X)
+	case *ast.IndexListExpr:
+		return calleeIdent(expr.X)
+	default:
+		return nil
+	}
+}
+
+// localStruct returns the named non-generic struct type declared in pkg typ or its element refers to.
+func localStruct(pkg *types.Package, typ types.Type) *types.Named {
+	typ = types.Unalias(typ)
+	if pointer, ok := typ.(*types.Pointer); ok {
+		typ = pointer.Elem()
+	}
+
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() != pkg || named.TypeParams().Len() > 0 {
+		return nil
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+
+	return named
+}
+
+// injectableFields returns the exported fields of the struct injected by a generated injector,
+// ok is false if any of the fields must be injected with reflection.
+func injectableFields(pkg *types.Package, st *types.Struct) ([]Field, bool) {
+	fields := []Field{}
+
+	for i := 0; i < st.NumFields(); i++ {
+		v := st.Field(i)
+		if !v.Exported() {
+			continue
+		}
+
+		tags, ok := parseTag(reflect.StructTag(st.Tag(i)).Get("pal"))
+		if !ok {
+			return nil, false
+		}
+		if _, skip := tags["skip"]; skip {
+			continue
+		}
+
+		_, optional := tags["optional"]
+		field := Field{Name: v.Name(), Type: v.Type(), Dependency: tags["name"], Optional: optional}
+
+		typ := types.Unalias(v.Type())
+		switch {
+		case isLogger(typ):
+			field.Kind = FieldLogger
+		case !expressible(pkg, typ) || isPalStruct(typ):
+			return nil, false
+		default:
+			if _, ok := typ.Underlying().(*types.Signature); ok {
+				field.Kind = FieldFactory
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, true
+}
+
+// parseTag parses a pal tag, ok is false if it contains tags that require reflection or is malformed.
+func parseTag(tag string) (map[string]string, bool) {
+	tags := map[string]string{}
+
+	tag = strings.ReplaceAll(tag, " ", "")
+	if tag == "" {
+		return tags, true
+	}
+
+	for part := range strings.SplitSeq(tag, ",") {
+		key, value, hasValue := strings.Cut(part, "=")
+
+		switch {
+		case key == "name" && hasValue && value != "" && !strings.Contains(value, "="):
+			tags[key] = value
+		case (key == "skip" || key == "optional") && !hasValue:
+			tags[key] = ""
+		default:
+			return nil, false
+		}
+	}
+
+	return tags, true
+}
+
+func isLogger(typ types.Type) bool {
+	pointer, ok := typ.(*types.Pointer)
+	if !ok {
+		return false
+	}
+	named, ok := pointer.Elem().(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "log/slog" && named.Obj().Name() == "Logger"
+}
+
+// isPalStruct reports whether the type is a struct declared by pal, such as Lazy.
+func isPalStruct(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != palPath {
+		return false
+	}
+	_, ok = named.Underlying().(*types.Struct)
+	return ok
+}
+
+// expressible reports whether the type can be written in code generated in pkg.
+func expressible(pkg *types.Package, typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.Basic:
+		return true
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() != nil && obj.Pkg() != pkg && !obj.Exported() {
+			return false
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			if !expressible(pkg, t.TypeArgs().At(i)) {
+				return false
+			}
+		}
+		return true
+	case *types.Alias:
+		return expressible(pkg, types.Unalias(t))
+	case *types.Pointer:
+		return expressible(pkg, t.Elem())
+	case *types.Slice:
+		return expressible(pkg, t.Elem())
+	case *types.Array:
+		return expressible(pkg, t.Elem())
+	case *types.Chan:
+		return expressible(pkg, t.Elem())
+	case *types.Map:
+		return expressible(pkg, t.Key()) && expressible(pkg, t.Elem())
+	case *types.Signature:
+		return tupleExpressible(pkg, t.Params()) && tupleExpressible(pkg, t.Results())
+	case *types.Interface:
+		return t.NumMethods() == 0 && t.NumEmbeddeds() == 0
+	default:
+		return false
+	}
+}
+
+func tupleExpressible(pkg *types.Package, tuple *types.Tuple) bool {
+	for i := 0; i < tuple.Len(); i++ {
+		if !expressible(pkg, tuple.At(i).Type()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,10 @@
+package gencmd
+
+import "errors"
+
+var (
+	// ErrLoad is returned when packages cannot be loaded or do not type check.
+	ErrLoad = errors.New("failed to load packages")
+	// ErrRender is returned when the generated code cannot be formatted.
+	ErrRender = errors.New("failed to render injectors")
+)
@@ -0,0 +1,60 @@
+package gencmd
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+	"github.com/zhulik/pal"
+	"github.com/zhulik/pal/cmd/pal/internal/cli/app"
+)
+
+const (
+	flagOutput  = "output"
+	argPackages = "packages"
+)
+
+// Options holds the gen configuration read from CLI flags/args.
+type Options struct {
+	// Dir is the directory packages are loaded from. Empty means the process working directory.
+	Dir string
+	// Patterns are the package patterns to generate injectors for, as accepted by `go list`.
+	Patterns []string
+	// Output is the name of the generated file written to each package directory.
+	Output string
+}
+
+// OptionsFromCommand reads Options from the parsed gen command.
+func OptionsFromCommand(cmd *cli.Command) Options {
+	patterns := cmd.StringArgs(argPackages)
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+	return Options{Patterns: patterns, Output: cmd.String(flagOutput)}
+}
+
+// New returns the gen subcommand.
+func New() *cli.Command {
+	return &cli.Command{
+		Name:  "gen",
+		Usage: "generate reflection-free injectors for services registered with pal",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    flagOutput,
+				Aliases: []string{"o"},
+				Value:   "pal_gen.go",
+				Usage:   "name of the file generated in each package",
+			},
+		},
+		Arguments: []cli.Argument{
+			&cli.StringArgs{
+				Name:      argPackages,
+				UsageText: "packages to analyze (default ./...)",
+				Min:       0,
+				Max:       -1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return app.Run(ctx, pal.Provide(&runner{opts: OptionsFromCommand(cmd)}))
+		},
+	}
+}
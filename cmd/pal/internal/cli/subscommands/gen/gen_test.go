@@ -0,0 +1,66 @@
+package gencmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	gencmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/gen"
+)
+
+func loadServices(t *testing.T) *packages.Package {
+	t.Helper()
+
+	pkgs, err := packages.Load(&packages.Config{
+		Context: t.Context(),
+		Dir:     filepath.Join("testdata", "services"),
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+	}, ".")
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+	require.Empty(t, pkgs[0].Errors)
+
+	return pkgs[0]
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	services := gencmd.Analyze(loadServices(t))
+
+	names := []string{}
+	for _, service := range services {
+		names = append(names, service.Type.Obj().Name())
+	}
+	// Worker has a group field and must be injected with reflection, Conn has no fields and Unregistered is not provided.
+	assert.Equal(t, []string{"Handler", "Reporter"}, names)
+
+	fields := map[string]gencmd.Field{}
+	for _, field := range services[0].Fields {
+		fields[field.Name] = field
+	}
+	assert.Len(t, fields, 5)
+	assert.Equal(t, gencmd.FieldService, fields["Repo"].Kind)
+	assert.Equal(t, "primary", fields["Primary"].Dependency)
+	assert.True(t, fields["Cache"].Optional)
+	assert.Equal(t, gencmd.FieldFactory, fields["NewConn"].Kind)
+	assert.Equal(t, gencmd.FieldLogger, fields["Logger"].Kind)
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	pkg := loadServices(t)
+
+	src, err := gencmd.Render(pkg.Types, gencmd.Analyze(pkg))
+	require.NoError(t, err)
+
+	// Regenerate with `go run . gen ./internal/cli/subscommands/gen/testdata/services` after changing the generator.
+	expected, err := os.ReadFile(filepath.Join("testdata", "services", "pal_gen.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(expected), string(src))
+}
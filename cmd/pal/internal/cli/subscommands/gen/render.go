@@ -0,0 +1,147 @@
+package gencmd
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by pal gen. DO NOT EDIT.\n"
+
+// Render returns the source of a file registering injectors of the services in pkg.
+func Render(pkg *types.Package, services []Service) ([]byte, error) {
+	imports := newImports(pkg)
+	palName := imports.name(palPath, "pal")
+	contextName := imports.name("context", "context")
+
+	var body bytes.Buffer
+	for _, service := range services {
+		renderService(&body, imports, palName, contextName, service)
+	}
+
+	var src bytes.Buffer
+	src.WriteString(header)
+	fmt.Fprintf(&src, "\npackage %s\n\nimport (\n", pkg.Name())
+	paths := slices.SortedFunc(maps.Keys(imports.names), func(a, b string) int {
+		// Standard library packages go first, as goimports groups them.
+		if isStd(a) != isStd(b) {
+			if isStd(a) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	for i, path := range paths {
+		if i > 0 && isStd(paths[i-1]) && !isStd(path) {
+			src.WriteString("\n")
+		}
+		if name := imports.names[path]; name != imports.pkgNames[path] {
+			fmt.Fprintf(&src, "\t%s %s\n", name, strconv.Quote(path))
+			continue
+		}
+		fmt.Fprintf(&src, "\t%s\n", strconv.Quote(path))
+	}
+	src.WriteString(")\n")
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRender, err)
+	}
+	return formatted, nil
+}
+
+func renderService(buf *bytes.Buffer, imports *imports, palName, contextName string, service Service) {
+	typeName := service.Type.Obj().Name()
+
+	fmt.Fprintf(buf, "\nfunc init() {\n\tfields := []%s.InjectedField{\n", palName)
+	for _, field := range service.Fields {
+		dependency := fmt.Sprintf("%s.ServiceName[%s]()", palName, imports.typeString(field.Type))
+		named := ""
+		if field.Dependency != "" {
+			dependency = strconv.Quote(field.Dependency)
+			named = ", Named: true"
+		}
+		optional := ""
+		if field.Optional {
+			optional = ", Optional: true"
+		}
+
+		fmt.Fprintf(buf, "\t\t{Name: %q, Dependency: %s%s%s},\n", field.Name, dependency, named, optional)
+	}
+	buf.WriteString("\t}\n\n")
+
+	fmt.Fprintf(buf, "\t%s.RegisterInjector(%s.Injector[%s]{\n", palName, palName, typeName)
+	buf.WriteString("\t\tFields: fields,\n")
+	fmt.Fprintf(buf, "\t\tInject: func(ctx %s.Context, c *%s.Container, target *%s) error {\n", contextName, palName, typeName)
+
+	index := 0
+	for _, field := range service.Fields {
+		switch field.Kind {
+		case FieldLogger:
+			fmt.Fprintf(buf, "\t\t\tif err := %s.InjectLogger(ctx, c, target, &target.%s, fields[%d]); err != nil {\n\t\t\t\treturn err\n\t\t\t}\n", palName, field.Name, index)
+		case FieldFactory:
+			fmt.Fprintf(buf, "\t\t\t%s.InjectFactory(c, &target.%s, fields[%d])\n", palName, field.Name, index)
+		case FieldService:
+			fmt.Fprintf(buf, "\t\t\tif err := %s.InjectService(ctx, c, target, &target.%s, fields[%d]); err != nil {\n\t\t\t\treturn err\n\t\t\t}\n", palName, field.Name, index)
+		}
+		index++
+	}
+
+	buf.WriteString("\t\t\treturn nil\n\t\t},\n\t})\n}\n")
+}
+
+// imports holds names of the packages imported by a generated file, keyed by path.
+type imports struct {
+	pkg      *types.Package
+	names    map[string]string
+	pkgNames map[string]string
+	taken    map[string]bool
+}
+
+func newImports(pkg *types.Package) *imports {
+	return &imports{
+		pkg:      pkg,
+		names:    map[string]string{},
+		pkgNames: map[string]string{},
+		// Names used in generated functions must not be shadowed by imports.
+		taken: map[string]bool{"ctx": true, "c": true, "target": true, "fields": true, "err": true},
+	}
+}
+
+// name returns the name the package is imported with, the package is imported if it is not yet.
+func (i *imports) name(path, name string) string {
+	if existing, ok := i.names[path]; ok {
+		return existing
+	}
+
+	unique := name
+	for n := 2; i.taken[unique] || i.pkg.Scope().Lookup(unique) != nil; n++ {
+		unique = name + strconv.Itoa(n)
+	}
+
+	i.names[path] = unique
+	i.pkgNames[path] = name
+	i.taken[unique] = true
+	return unique
+}
+
+func (i *imports) typeString(typ types.Type) string {
+	return types.TypeString(types.Unalias(typ), func(pkg *types.Package) string {
+		if pkg == i.pkg {
+			return ""
+		}
+		return i.name(pkg.Path(), pkg.Name())
+	})
+}
+
+func isStd(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
@@ -0,0 +1,85 @@
+package gencmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+type runner struct {
+	opts Options
+}
+
+// Run generates injectors for the packages matching opts. Prefer this from tests and other
+// callers; the CLI Action goes through app.Run → pal with an unexported runner.
+func Run(ctx context.Context, opts Options) error {
+	return (&runner{opts: opts}).Run(ctx)
+}
+
+func (r *runner) Run(ctx context.Context) error {
+	pkgs, err := packages.Load(&packages.Config{
+		Context: ctx,
+		Dir:     r.opts.Dir,
+		Mode:    packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+	}, r.opts.Patterns...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLoad, err)
+	}
+
+	for _, pkg := range pkgs {
+		if err := r.generate(pkg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generate writes the injectors of the package to the output file, or removes a previously generated file
+// if the package has no services anymore.
+func (r *runner) generate(pkg *packages.Package) error {
+	if len(pkg.GoFiles) == 0 {
+		return nil
+	}
+
+	// A stale generated file may not compile, its errors are irrelevant as it is regenerated.
+	path := filepath.Join(filepath.Dir(pkg.GoFiles[0]), r.opts.Output)
+	for _, pkgErr := range pkg.Errors {
+		if !strings.HasPrefix(pkgErr.Pos, path+":") {
+			return fmt.Errorf("%w: %s: %s", ErrLoad, pkg.PkgPath, pkgErr)
+		}
+	}
+
+	services := Analyze(pkg)
+	if len(services) == 0 {
+		return removeGenerated(path)
+	}
+
+	src, err := Render(pkg.Types, services)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, src, 0o644) //nolint:gosec
+}
+
+// removeGenerated removes the file at path if it was generated.
+func removeGenerated(path string) error {
+	src, err := os.ReadFile(path) //nolint:gosec
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(src, []byte(header)) {
+		return nil
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,57 @@
+// Code generated by pal gen. DO NOT EDIT.
+
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/zhulik/pal"
+)
+
+func init() {
+	fields := []pal.InjectedField{
+		{Name: "Repo", Dependency: pal.ServiceName[Repo]()},
+		{Name: "Primary", Dependency: "primary", Named: true},
+		{Name: "Cache", Dependency: pal.ServiceName[io.Reader](), Optional: true},
+		{Name: "NewConn", Dependency: pal.ServiceName[func(ctx context.Context) (*Conn, error)]()},
+		{Name: "Logger", Dependency: pal.ServiceName[*slog.Logger]()},
+	}
+
+	pal.RegisterInjector(pal.Injector[Handler]{
+		Fields: fields,
+		Inject: func(ctx context.Context, c *pal.Container, target *Handler) error {
+			if err := pal.InjectService(ctx, c, target, &target.Repo, fields[0]); err != nil {
+				return err
+			}
+			if err := pal.InjectService(ctx, c, target, &target.Primary, fields[1]); err != nil {
+				return err
+			}
+			if err := pal.InjectService(ctx, c, target, &target.Cache, fields[2]); err != nil {
+				return err
+			}
+			pal.InjectFactory(c, &target.NewConn, fields[3])
+			if err := pal.InjectLogger(ctx, c, target, &target.Logger, fields[4]); err != nil {
+				return err
+			}
+			return nil
+		},
+	})
+}
+
+func init() {
+	fields := []pal.InjectedField{
+		{Name: "Handler", Dependency: pal.ServiceName[*Handler]()},
+	}
+
+	pal.RegisterInjector(pal.Injector[Reporter]{
+		Fields: fields,
+		Inject: func(ctx context.Context, c *pal.Container, target *Reporter) error {
+			if err := pal.InjectService(ctx, c, target, &target.Handler, fields[0]); err != nil {
+				return err
+			}
+			return nil
+		},
+	})
+}
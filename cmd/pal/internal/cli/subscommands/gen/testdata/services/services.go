@@ -0,0 +1,66 @@
+// Package services is analyzed by the gen tests, pal_gen.go is the expected output.
+package services
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/zhulik/pal"
+)
+
+type Repo interface {
+	Find(ctx context.Context, id string) (string, error)
+}
+
+type repo struct{}
+
+func (r *repo) Find(_ context.Context, id string) (string, error) {
+	return id, nil
+}
+
+type Conn struct{}
+
+// Handler is injected without reflection.
+type Handler struct {
+	Repo    Repo
+	Primary Repo      `pal:"name=primary"`
+	Cache   io.Reader `pal:"optional"`
+	NewConn func(ctx context.Context) (*Conn, error)
+	Logger  *slog.Logger
+
+	Ignored Repo `pal:"skip"`
+	private Repo
+}
+
+// Worker is injected with reflection because of its group field.
+type Worker struct {
+	Handlers []*Handler `pal:"group=handlers"`
+}
+
+// Reporter is created by a constructor.
+type Reporter struct {
+	Handler *Handler
+}
+
+func NewReporter(_ context.Context, handler *Handler) (*Reporter, error) {
+	return &Reporter{Handler: handler}, nil
+}
+
+// Unregistered is not registered, so no injector is generated for it.
+type Unregistered struct {
+	Repo Repo
+}
+
+func Services() []pal.ServiceDef {
+	return []pal.ServiceDef{
+		pal.Provide[Repo](&repo{}),
+		pal.ProvideNamed[Repo]("primary", &repo{}),
+		pal.Provide(&Handler{}),
+		pal.Provide(&Worker{}),
+		pal.ProvideConstructor[*Reporter](NewReporter),
+		pal.ProvideFactory0[*Conn](func(_ context.Context) (*Conn, error) {
+			return &Conn{}, nil
+		}),
+	}
+}
@@ -0,0 +1,10 @@
+package lintcmd
+
+import "errors"
+
+var (
+	// ErrLoad is returned when packages cannot be loaded or do not type check.
+	ErrLoad = errors.New("failed to load packages")
+	// ErrProblems is returned when the analyzer reports problems.
+	ErrProblems = errors.New("problems found")
+)
@@ -0,0 +1,35 @@
+package lintcmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/urfave/cli/v3"
+	"github.com/zhulik/pal"
+	"github.com/zhulik/pal/cmd/pal/internal/cli/app"
+)
+
+const argPackages = "packages"
+
+// New returns the lint subcommand.
+func New() *cli.Command {
+	return &cli.Command{
+		Name:  "lint",
+		Usage: "report misuses of pal, see the github.com/zhulik/pal/cmd/pal/analysis package",
+		Arguments: []cli.Argument{
+			&cli.StringArgs{
+				Name:      argPackages,
+				UsageText: "packages to analyze (default ./...)",
+				Min:       0,
+				Max:       -1,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			patterns := cmd.StringArgs(argPackages)
+			if len(patterns) == 0 {
+				patterns = []string{"./..."}
+			}
+			return app.Run(ctx, pal.Provide(&runner{opts: Options{Patterns: patterns, Output: os.Stdout}}))
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package lintcmd_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	lintcmd "github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/lint"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	err := lintcmd.Run(t.Context(), lintcmd.Options{
+		Dir:      filepath.Join("testdata", "services"),
+		Patterns: []string{"."},
+		Output:   &out,
+	})
+
+	require.ErrorIs(t, err, lintcmd.ErrProblems)
+	assert.Contains(t, out.String(), `services.go:11:11: unknown pal tag "nmae"`)
+	assert.Contains(t, out.String(), "ToInit hook replaces the Init method of *github.com/zhulik/pal/cmd/pal/internal/cli/subscommands/lint/testdata/services.Handler")
+}
@@ -0,0 +1,69 @@
+package lintcmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	palanalysis "github.com/zhulik/pal/cmd/pal/analysis"
+)
+
+// Options holds the lint configuration read from CLI args.
+type Options struct {
+	// Dir is the directory packages are loaded from. Empty means the process working directory.
+	Dir string
+	// Patterns are the package patterns to analyze, as accepted by `go list`.
+	Patterns []string
+	// Output receives the reported problems.
+	Output io.Writer
+}
+
+type runner struct {
+	opts Options
+}
+
+// Run analyzes the packages matching opts and prints problems found. Prefer this from tests and other
+// callers; the CLI Action goes through app.Run → pal with an unexported runner.
+func Run(ctx context.Context, opts Options) error {
+	return (&runner{opts: opts}).Run(ctx)
+}
+
+func (r *runner) Run(ctx context.Context) error {
+	pkgs, err := packages.Load(&packages.Config{
+		Context: ctx,
+		Dir:     r.opts.Dir,
+		Mode:    packages.LoadAllSyntax,
+		Tests:   true,
+	}, r.opts.Patterns...)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrLoad, err)
+	}
+	if packages.PrintErrors(pkgs) > 0 {
+		return ErrLoad
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{palanalysis.Analyzer}, pkgs, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := graph.PrintText(r.opts.Output, 0); err != nil {
+		return err
+	}
+
+	problems := 0
+	for action := range graph.All() {
+		if action.IsRoot {
+			problems += len(action.Diagnostics)
+		}
+	}
+	if problems > 0 {
+		return fmt.Errorf("%w: %d", ErrProblems, problems)
+	}
+
+	return nil
+}
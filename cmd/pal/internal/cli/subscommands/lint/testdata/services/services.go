@@ -0,0 +1,24 @@
+// Package services is analyzed by the lint tests.
+package services
+
+import (
+	"context"
+
+	"github.com/zhulik/pal"
+)
+
+type Handler struct {
+	Repo any `pal:"nmae=repo"`
+}
+
+func (h *Handler) Init(_ context.Context) error {
+	return nil
+}
+
+func Services() []pal.ServiceDef {
+	return []pal.ServiceDef{
+		pal.Provide(&Handler{}).ToInit(func(_ context.Context, _ *Handler, _ pal.Invoker) error {
+			return nil
+		}),
+	}
+}
@@ -2,6 +2,7 @@ package pal_test
 
 import (
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -69,6 +70,24 @@ type factoryMultiLabel struct {
 	R          rune
 }
 
+// shutdownLog records names of shut down services in shutdown order.
+type shutdownLog struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (l *shutdownLog) add(name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.names = append(l.names, name)
+}
+
+func (l *shutdownLog) get() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.names
+}
+
 func newPal(services ...pal.ServiceDef) *pal.Pal {
 	return pal.New(services...).
 		InitTimeout(time.Second).
@@ -21,6 +21,15 @@ type Config struct {
 	HealthCheckTimeout time.Duration `validate:"gt=0"`
 	ShutdownTimeout    time.Duration `validate:"gt=0"`
 
+	// InitConcurrency limits the number of services initialized concurrently. 0 means no limit.
+	InitConcurrency int `validate:"gte=0"`
+
+	// SequentialShutdown makes Pal shut services down one by one instead of concurrently.
+	SequentialShutdown bool
+
+	// Strict makes unresolved exported interface and pointer fields an injection error.
+	Strict bool
+
 	AttrSetters []SlogAttributeSetter
 }
 
@@ -0,0 +1,199 @@
+// Package config provides layered application configuration for Pal.
+//
+// Values are loaded from sources such as environment variables, JSON files, maps and command-line flags,
+// and merged in precedence order. Fields of services tagged with `pal:"config=<key>"` are populated with
+// the value found under the key and validated with `validate` tags during Init.
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/zhulik/pal"
+)
+
+// Config holds configuration values merged from sources. It implements [pal.ConfigProvider].
+type Config struct {
+	sources   []Source
+	validator *validator.Validate
+
+	mu     sync.RWMutex
+	values map[string]any
+}
+
+// New creates a Config loading values from the sources. Sources are merged in the given order,
+// values from later sources take precedence over values from earlier ones.
+func New(sources ...Source) *Config {
+	return &Config{
+		sources:   sources,
+		validator: validator.New(),
+		values:    map[string]any{},
+	}
+}
+
+// Provide registers a Config loading values from the sources, see [New].
+func Provide(sources ...Source) pal.ServiceDef {
+	return pal.Provide(New(sources...))
+}
+
+// Init loads and merges values from all sources.
+func (c *Config) Init(ctx context.Context) error {
+	values := map[string]any{}
+
+	for i, source := range c.sources {
+		loaded, err := source.Load(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to load config source %d: %w", i, err)
+		}
+
+		merge(values, expand(loaded))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.values = values
+
+	return nil
+}
+
+// Lookup returns the value found under the key, nested values are returned as map[string]any.
+func (c *Config) Lookup(key string) (any, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var current any = c.values
+	for part := range strings.SplitSeq(key, ".") {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = node[part]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// Decode decodes the value found under the key into the target, which must be a non-nil pointer.
+// Returns an error wrapping [pal.ErrConfigKeyNotFound] if there is no value under the key.
+func (c *Config) Decode(key string, target any) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return fmt.Errorf("%w: target must be a non-nil pointer, got %T", pal.ErrConfigInvalid, target)
+	}
+
+	return c.decodeKey(key, value.Elem())
+}
+
+// InjectConfig decodes the value found under the key into the field value, then validates it using the
+// `validate` tag of the field and, for structs, the `validate` tags of their fields.
+func (c *Config) InjectConfig(_ context.Context, key string, field reflect.StructField, value reflect.Value) error {
+	if err := c.decodeKey(key, value); err != nil {
+		return err
+	}
+
+	if tag := field.Tag.Get("validate"); tag != "" {
+		if err := c.validator.Var(value.Interface(), tag); err != nil {
+			return validationError(key, err)
+		}
+	}
+
+	if structValue := reflect.Indirect(value); structValue.Kind() == reflect.Struct {
+		if err := c.validator.Struct(structValue.Interface()); err != nil {
+			return validationError(key, err)
+		}
+	}
+
+	return nil
+}
+
+// validationError describes all failed validations of the value found under the key.
+// Failed nested fields are named by their path from the key, for instance db.URL.
+func validationError(key string, err error) error {
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return fmt.Errorf("%w: '%s': %w", pal.ErrConfigInvalid, key, err)
+	}
+
+	failures := make([]string, len(fieldErrs))
+	for i, fieldErr := range fieldErrs {
+		name := key
+		if _, path, ok := strings.Cut(fieldErr.StructNamespace(), "."); ok {
+			name = key + "." + path
+		}
+		failures[i] = fmt.Sprintf("'%s' failed on the '%s' validation", name, fieldErr.Tag())
+	}
+
+	return fmt.Errorf("%w: %s", pal.ErrConfigInvalid, strings.Join(failures, ", "))
+}
+
+func (c *Config) decodeKey(key string, target reflect.Value) error {
+	raw, ok := c.Lookup(key)
+	if !ok {
+		return fmt.Errorf("%w: '%s'", pal.ErrConfigKeyNotFound, key)
+	}
+
+	if err := decode(raw, target); err != nil {
+		return fmt.Errorf("%w: '%s': %w", pal.ErrConfigInvalid, key, err)
+	}
+
+	return nil
+}
+
+// expand turns dotted keys into nested maps.
+func expand(values map[string]any) map[string]any {
+	result := map[string]any{}
+
+	for key, value := range values {
+		if nested, ok := value.(map[string]any); ok {
+			value = expand(nested)
+		}
+
+		parts := strings.Split(key, ".")
+		node := result
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]any)
+			if !ok {
+				child = map[string]any{}
+				node[part] = child
+			}
+			node = child
+		}
+
+		last := parts[len(parts)-1]
+		if existing, ok := node[last].(map[string]any); ok {
+			if nested, ok := value.(map[string]any); ok {
+				merge(existing, nested)
+				continue
+			}
+		}
+		node[last] = value
+	}
+
+	return result
+}
+
+// merge deeply merges src into dst, values from src take precedence.
+func merge(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+
+		if srcIsMap && dstIsMap {
+			merge(dstMap, srcMap)
+			continue
+		}
+
+		dst[key] = value
+	}
+}
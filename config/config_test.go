@@ -0,0 +1,168 @@
+package config_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+	"github.com/zhulik/pal/config"
+	"github.com/zhulik/pal/paltest"
+)
+
+type dbConfig struct {
+	URL      string `validate:"required,url"`
+	MaxConns int    `config:"max_conns" validate:"gte=1"`
+}
+
+type configuredService struct {
+	URL      string        `pal:"config=db.url" validate:"required,url"`
+	Port     int           `pal:"config=db.port"`
+	Timeout  time.Duration `pal:"config=db.timeout"`
+	Hosts    []string      `pal:"config=hosts"`
+	Debug    bool          `pal:"config=debug"`
+	DB       dbConfig      `pal:"config=db"`
+	Optional string        `pal:"config=missing,optional"`
+}
+
+type invalidConfigService struct {
+	URL  string   `pal:"config=db.url" validate:"url"`
+	DB   dbConfig `pal:"config=db"`
+	Port int      `pal:"config=db.port"`
+}
+
+type missingConfigService struct {
+	URL string `pal:"config=db.url"`
+}
+
+func newPal(services ...pal.ServiceDef) *pal.Pal {
+	return pal.New(services...).
+		InitTimeout(time.Second).
+		HealthCheckTimeout(time.Second).
+		ShutdownTimeout(time.Second)
+}
+
+func writeJSON(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+// TestConfig_InjectConfig tests the InjectConfig method of Config
+func TestConfig_InjectConfig(t *testing.T) {
+	t.Run("injects values merged from sources in precedence order", func(t *testing.T) {
+		t.Setenv("PALTEST_DB_PORT", "5434")
+
+		flags := flag.NewFlagSet("test", flag.ContinueOnError)
+		flags.Bool("debug", false, "")
+		require.NoError(t, flags.Parse([]string{"-debug"}))
+
+		service := &configuredService{}
+
+		paltest.New(t,
+			pal.Provide(service),
+			config.Provide(
+				config.Map(map[string]any{"db.port": 5432, "db.timeout": "5s", "db.max_conns": 1}),
+				config.JSONFile(writeJSON(t, `{"db": {"url": "postgres://localhost/db", "port": 5433, "max_conns": 10}, "hosts": ["a", "b"]}`)),
+				config.Env("PALTEST"),
+				config.Flags(flags),
+			),
+		)
+
+		assert.Equal(t, "postgres://localhost/db", service.URL)
+		assert.Equal(t, 5434, service.Port)
+		assert.Equal(t, 5*time.Second, service.Timeout)
+		assert.Equal(t, []string{"a", "b"}, service.Hosts)
+		assert.True(t, service.Debug)
+		assert.Equal(t, dbConfig{URL: "postgres://localhost/db", MaxConns: 10}, service.DB)
+		assert.Empty(t, service.Optional)
+	})
+
+	t.Run("reports all invalid fields", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&invalidConfigService{}),
+			config.Provide(config.Map(map[string]any{"db.url": "not a url", "db.port": "abc", "db.max_conns": 0})),
+		)
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, pal.ErrConfigInvalid)
+		assert.ErrorContains(t, err, "config field 'URL'")
+		assert.ErrorContains(t, err, "config field 'DB'")
+		assert.ErrorContains(t, err, "config field 'Port'")
+	})
+
+	t.Run("returns error when key is missing", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&missingConfigService{}), config.Provide())
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrConfigKeyNotFound)
+	})
+
+	t.Run("returns error when config is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&missingConfigService{}))
+
+		_, err := p.Validate(t.Context())
+		require.ErrorIs(t, err, pal.ErrServiceNotFound)
+
+		err = p.Init(t.Context())
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+}
+
+// TestConfig_Env tests the Env source
+func TestConfig_Env(t *testing.T) {
+	t.Run("maps underscores to dots and double underscores to underscores", func(t *testing.T) {
+		t.Setenv("PALTEST_DB_URL", "postgres://localhost/db")
+		t.Setenv("PALTEST_DB_MAX__CONNS", "20")
+
+		values, err := config.Env("PALTEST").Load(t.Context())
+		require.NoError(t, err)
+
+		assert.Equal(t, "postgres://localhost/db", values["db.url"])
+		assert.Equal(t, "20", values["db.max_conns"])
+		assert.NotContains(t, values, "db.max..conns")
+	})
+}
+
+// TestConfig_Decode tests the Decode method of Config
+func TestConfig_Decode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("decodes nested values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New(config.Map(map[string]any{"db": map[string]any{"url": "postgres://localhost/db"}, "db.max_conns": "3"}))
+		require.NoError(t, cfg.Init(t.Context()))
+
+		var db dbConfig
+		require.NoError(t, cfg.Decode("db", &db))
+
+		assert.Equal(t, dbConfig{URL: "postgres://localhost/db", MaxConns: 3}, db)
+	})
+
+	t.Run("returns error when value cannot be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.New(config.Map(map[string]any{"port": "abc"}))
+		require.NoError(t, cfg.Init(t.Context()))
+
+		var port int
+		assert.ErrorIs(t, cfg.Decode("port", &port), pal.ErrConfigInvalid)
+	})
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// decode converts the raw value loaded from sources into the target.
+// Strings are parsed into numbers, booleans and durations, comma separated strings are split into slices,
+// maps are decoded into structs and maps.
+func decode(raw any, target reflect.Value) error {
+	if raw == nil {
+		return nil
+	}
+
+	rawValue := reflect.ValueOf(raw)
+	if rawValue.Type().AssignableTo(target.Type()) {
+		target.Set(rawValue)
+		return nil
+	}
+
+	if target.Type() == durationType {
+		return decodeDuration(raw, target)
+	}
+
+	switch target.Kind() {
+	case reflect.Pointer:
+		value := reflect.New(target.Type().Elem())
+		if err := decode(raw, value.Elem()); err != nil {
+			return err
+		}
+		target.Set(value)
+		return nil
+	case reflect.String:
+		target.SetString(fmt.Sprint(raw))
+		return nil
+	case reflect.Bool:
+		return decodeScalar(raw, target, func(s string) error {
+			b, err := strconv.ParseBool(s)
+			target.SetBool(b)
+			return err
+		})
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decodeScalar(raw, target, func(s string) error {
+			i, err := strconv.ParseInt(s, 10, target.Type().Bits())
+			target.SetInt(i)
+			return err
+		})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return decodeScalar(raw, target, func(s string) error {
+			u, err := strconv.ParseUint(s, 10, target.Type().Bits())
+			target.SetUint(u)
+			return err
+		})
+	case reflect.Float32, reflect.Float64:
+		return decodeScalar(raw, target, func(s string) error {
+			f, err := strconv.ParseFloat(s, target.Type().Bits())
+			target.SetFloat(f)
+			return err
+		})
+	case reflect.Slice:
+		return decodeSlice(raw, target)
+	case reflect.Map:
+		return decodeMap(raw, target)
+	case reflect.Struct:
+		return decodeStruct(raw, target)
+	default:
+		return fmt.Errorf("unsupported type %s", target.Type())
+	}
+}
+
+// decodeScalar converts the raw value into a string and parses it with parse.
+// Numbers from JSON are formatted without exponent, so they can be parsed as integers.
+func decodeScalar(raw any, target reflect.Value, parse func(s string) error) error {
+	var s string
+	switch value := raw.(type) {
+	case string:
+		s = strings.TrimSpace(value)
+	case float64:
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		s = fmt.Sprint(value)
+	}
+
+	if err := parse(s); err != nil {
+		return fmt.Errorf("cannot decode %q into %s", s, target.Type())
+	}
+
+	return nil
+}
+
+// decodeDuration decodes strings like "1m30s" and integer numbers of nanoseconds.
+func decodeDuration(raw any, target reflect.Value) error {
+	if s, ok := raw.(string); ok {
+		duration, err := time.ParseDuration(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", s, target.Type())
+		}
+		target.SetInt(int64(duration))
+		return nil
+	}
+
+	return decodeScalar(raw, target, func(s string) error {
+		i, err := strconv.ParseInt(s, 10, 64)
+		target.SetInt(i)
+		return err
+	})
+}
+
+// decodeSlice decodes slices and comma separated strings.
+func decodeSlice(raw any, target reflect.Value) error {
+	var items []any
+
+	switch value := raw.(type) {
+	case string:
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+	default:
+		rawValue := reflect.ValueOf(raw)
+		if rawValue.Kind() != reflect.Slice && rawValue.Kind() != reflect.Array {
+			return fmt.Errorf("cannot decode %T into %s", raw, target.Type())
+		}
+		for i := 0; i < rawValue.Len(); i++ {
+			items = append(items, rawValue.Index(i).Interface())
+		}
+	}
+
+	slice := reflect.MakeSlice(target.Type(), len(items), len(items))
+	for i, item := range items {
+		if err := decode(item, slice.Index(i)); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+
+	target.Set(slice)
+	return nil
+}
+
+// decodeMap decodes nested values into a map with string keys.
+func decodeMap(raw any, target reflect.Value) error {
+	values, ok := raw.(map[string]any)
+	if !ok || target.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("cannot decode %T into %s", raw, target.Type())
+	}
+
+	result := reflect.MakeMapWithSize(target.Type(), len(values))
+	for key, value := range values {
+		item := reflect.New(target.Type().Elem()).Elem()
+		if err := decode(value, item); err != nil {
+			return fmt.Errorf("key '%s': %w", key, err)
+		}
+		result.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), item)
+	}
+
+	target.Set(result)
+	return nil
+}
+
+// decodeStruct decodes nested values into exported fields of a struct. Fields are looked up by the `config` tag,
+// or by the lowercased field name. Fields without values keep their current values.
+func decodeStruct(raw any, target reflect.Value) error {
+	values, ok := raw.(map[string]any)
+	if !ok {
+		return fmt.Errorf("cannot decode %T into %s", raw, target.Type())
+	}
+
+	typ := target.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Tag.Get("config")
+		if key == "" {
+			key = strings.ToLower(field.Name)
+		}
+		if key == "-" {
+			continue
+		}
+
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+
+		if err := decode(value, target.Field(i)); err != nil {
+			return fmt.Errorf("field '%s': %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
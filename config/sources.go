@@ -0,0 +1,103 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Source loads configuration values. Values are returned as a tree of nested maps, keys may also contain dots
+// to address nested values, for instance {"db.url": "..."} is the same as {"db": {"url": "..."}}.
+type Source interface {
+	Load(ctx context.Context) (map[string]any, error)
+}
+
+// SourceFunc is a function implementing [Source].
+type SourceFunc func(ctx context.Context) (map[string]any, error)
+
+// Load calls the function.
+func (f SourceFunc) Load(ctx context.Context) (map[string]any, error) {
+	return f(ctx)
+}
+
+// Env loads values from environment variables starting with the prefix followed by an underscore.
+// The prefix is removed, the rest of the name is lowercased and underscores are replaced with dots,
+// for instance APP_DB_URL is loaded as db.url with the APP prefix. A double underscore stands for an underscore
+// in the key, APP_DB_MAX__CONNS is loaded as db.max_conns. An empty prefix loads all variables.
+func Env(prefix string) Source {
+	return SourceFunc(func(_ context.Context) (map[string]any, error) {
+		values := map[string]any{}
+
+		for _, entry := range os.Environ() {
+			name, value, _ := strings.Cut(entry, "=")
+
+			if prefix != "" {
+				var ok bool
+				name, ok = strings.CutPrefix(name, prefix+"_")
+				if !ok {
+					continue
+				}
+			}
+
+			values[envKey(name)] = value
+		}
+
+		return values, nil
+	})
+}
+
+// envKey converts the name of an environment variable to a key: single underscores become dots,
+// double underscores become underscores.
+func envKey(name string) string {
+	parts := strings.Split(strings.ToLower(name), "__")
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(part, "_", ".")
+	}
+	return strings.Join(parts, "_")
+}
+
+// JSONFile loads values from a JSON file containing an object.
+func JSONFile(path string) Source {
+	return SourceFunc(func(_ context.Context) (map[string]any, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		values := map[string]any{}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		return values, nil
+	})
+}
+
+// Map loads values from the given map, useful for defaults and tests.
+func Map(values map[string]any) Source {
+	return SourceFunc(func(_ context.Context) (map[string]any, error) {
+		return values, nil
+	})
+}
+
+// Flags loads values of the flags explicitly set on the command line, flag names are used as keys,
+// for instance -db.url. Flags that are not set are ignored, so they do not override values from other sources.
+// The flag set must be parsed before Pal is initialized.
+func Flags(fs *flag.FlagSet) Source {
+	return SourceFunc(func(_ context.Context) (map[string]any, error) {
+		values := map[string]any{}
+
+		fs.Visit(func(f *flag.Flag) {
+			if getter, ok := f.Value.(flag.Getter); ok {
+				values[f.Name] = getter.Get()
+				return
+			}
+			values[f.Name] = f.Value.String()
+		})
+
+		return values, nil
+	})
+}
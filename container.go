@@ -8,11 +8,12 @@ import (
 	"maps"
 	"reflect"
 	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	typetostring "github.com/samber/go-type-to-string"
 
-	"golang.org/x/sync/errgroup"
-
 	"github.com/zhulik/pal/internal/dag"
 )
 
@@ -26,6 +27,11 @@ type factoryService interface {
 	MustFactory() any
 }
 
+// groupMember is implemented by services registered with ProvideToGroup and similar functions.
+type groupMember interface {
+	groupName() string
+}
+
 // Container is responsible for storing services, instances and the dependency graph.
 //
 // Advanced: prefer [Pal] for normal apps; Container remains exported for power users
@@ -35,8 +41,32 @@ type Container struct {
 
 	services  map[string]ServiceDef
 	factories map[string]factoryServiceMaping
+	groups    map[string][]ServiceDef // group members in registration order
 	graph     *dag.DAG[string, ServiceDef]
 	logger    *slog.Logger
+
+	// decorators holds decorators of services in registration order, keyed by the decorated service name.
+	decorators map[string][]ServiceDef
+
+	// lazyEdges holds dependencies injected as Lazy, they are not part of the graph as they don't
+	// affect initialization order.
+	lazyEdges map[string]map[string]bool
+
+	// initStates tracks initialization of services, so each service is initialized only once, even if it is
+	// requested on demand by a Lazy dependency while the container is being initialized.
+	initStates map[string]*initState
+	// initialized holds names of services that successfully went through Init,
+	// only those are shut down.
+	initialized map[string]bool
+	// lastHealthy holds the time of the last successful health check of services.
+	lastHealthy map[string]time.Time
+	stateMu     sync.Mutex
+}
+
+// initState is the state of a service initialization, done is closed when the initialization is finished.
+type initState struct {
+	done chan struct{}
+	err  error
 }
 
 // NewContainer creates a new Container instance.
@@ -49,8 +79,15 @@ func NewContainer(pal *Pal, services ...ServiceDef) *Container {
 		pal:       pal,
 		services:  map[string]ServiceDef{},
 		factories: map[string]factoryServiceMaping{},
+		groups:    map[string][]ServiceDef{},
 		graph:     dag.New[string, ServiceDef](),
 		logger:    slog.With("palComponent", "Container"),
+
+		decorators:  map[string][]ServiceDef{},
+		lazyEdges:   map[string]map[string]bool{},
+		initStates:  map[string]*initState{},
+		initialized: map[string]bool{},
+		lastHealthy: map[string]time.Time{},
 	}
 
 	for _, service := range services {
@@ -80,21 +117,22 @@ func NewContainer(pal *Pal, services ...ServiceDef) *Container {
 func (c *Container) Init(ctx context.Context) error {
 	c.logger.Debug("Building dependency tree...")
 
+	if err := c.linkDecorators(); err != nil {
+		return err
+	}
+
 	for _, service := range c.services {
-		if err := c.addDependencyVertex(service, nil); err != nil {
+		if err := c.addDependencyVertex(c.graph, c.lazyEdges, service, nil); err != nil {
 			return err
 		}
 	}
 
-	for _, service := range c.graph.ReverseTopologicalOrder() {
-		initer, ok := service.(serviceIniter)
-		if !ok {
-			continue
-		}
-		if err := initer.Init(ctx); err != nil {
-			c.logger.Error("Failed to initialize container", "error", err)
-			return err
-		}
+	// Services are initialized as soon as all their dependencies are initialized,
+	// independent services are initialized concurrently.
+	err := walkGraph(ctx, c.graph, dependenciesFirst, c.config().InitConcurrency, true, c.initService)
+	if err != nil {
+		c.logger.Error("Failed to initialize container", "error", err)
+		return err
 	}
 
 	c.logger.Debug("Container initialized")
@@ -106,6 +144,7 @@ func (c *Container) Invoke(ctx context.Context, name string, args ...any) (any,
 	if !ok {
 		return nil, fmt.Errorf("%w: '%s', known services: %s", ErrServiceNotFound, name, c.services)
 	}
+	service = c.decorated(service)
 
 	if len(args) != service.Arguments() {
 		return nil, fmt.Errorf("%w: '%s': %d arguments expected, got %d", ErrServiceInvalidArgumentsCount, name, service.Arguments(), len(args))
@@ -124,8 +163,33 @@ func (c *Container) InvokeByInterface(ctx context.Context, iface reflect.Type, a
 		return nil, fmt.Errorf("%w: must be an interface, got %s", ErrNotAnInterface, iface.String())
 	}
 
+	matches := c.servicesImplementing(iface)
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w: no implementations of %s found", ErrServiceNotFound, iface.String())
+	}
+
+	if len(matches) == 1 {
+		return c.decorated(matches[0]).Instance(ctx, args...)
+	}
+
+	return nil, fmt.Errorf("%w: found %d services for interface %s", ErrMultipleServicesFoundByInterface, len(matches), iface.String())
+}
+
+// servicesImplementing returns all registered services whose instances implement the given interface.
+// Decorators are never returned, the decorated services are returned instead. Pooled services and group members
+// are never returned either, group members are only injected as part of their group.
+func (c *Container) servicesImplementing(iface reflect.Type) []ServiceDef {
 	matches := []ServiceDef{}
 	for _, service := range c.services {
+		if _, ok := service.(decorator); ok {
+			continue
+		}
+		if _, ok := service.(pooledService); ok {
+			continue
+		}
+		if member, ok := service.(groupMember); ok && member.groupName() != "" {
+			continue
+		}
 		instance := service.Make()
 		if instance == nil {
 			continue
@@ -134,21 +198,25 @@ func (c *Container) InvokeByInterface(ctx context.Context, iface reflect.Type, a
 			matches = append(matches, service)
 		}
 	}
-	if len(matches) == 0 {
-		return nil, fmt.Errorf("%w: no implementations of %s found", ErrServiceNotFound, iface.String())
-	}
-
-	if len(matches) == 1 {
-		return matches[0].Instance(ctx, args...)
-	}
-
-	return nil, fmt.Errorf("%w: found %d services for interface %s", ErrMultipleServicesFoundByInterface, len(matches), iface.String())
+	return matches
 }
 
 func (c *Container) InjectInto(ctx context.Context, target any) error {
+	return c.injectInto(ctx, target, "")
+}
+
+// injectInto injects dependencies into the target, fields resolving to the service named skip are not injected.
+func (c *Container) injectInto(ctx context.Context, target any, skip string) error {
 	v := reflect.ValueOf(target).Elem()
 	t := v.Type()
 
+	if injector, ok := injectorFor(t); ok && skip == "" {
+		return injector.inject(ctx, c, target)
+	}
+
+	// Config errors are collected to report all invalid config fields at once.
+	configErrs := []error{}
+
 	for i := 0; i < t.NumField(); i++ {
 		field := v.Field(i)
 
@@ -167,6 +235,11 @@ func (c *Container) InjectInto(ctx context.Context, target any) error {
 			continue
 		}
 
+		if isLazyField(fieldType) {
+			c.injectLazy(lazyFieldDependencyName(fieldType, tags), field)
+			continue
+		}
+
 		if _, ok := tags[TagMatchInterface]; ok {
 			err = c.injectByInterface(ctx, field, fieldType)
 			if err != nil {
@@ -175,12 +248,46 @@ func (c *Container) InjectInto(ctx context.Context, target any) error {
 			continue
 		}
 
-		typeName, mustInject := tags[TagName]
+		if group, ok := tags[TagGroup]; ok {
+			err = c.injectGroup(ctx, group, field)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if pattern, ok := tags[TagNames]; ok {
+			err = c.injectServiceMap(ctx, pattern, field, target)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if key, ok := tags[TagConfig]; ok {
+			err = c.injectConfig(ctx, key, t.Field(i), field)
+			if err != nil {
+				if _, optional := tags[TagOptional]; optional && errors.Is(err, ErrConfigKeyNotFound) {
+					continue
+				}
+				if !errors.Is(err, ErrConfigKeyNotFound) && !errors.Is(err, ErrConfigInvalid) {
+					return err
+				}
+				configErrs = append(configErrs, fmt.Errorf("config field '%s' of %s: %w", t.Field(i).Name, t, err))
+			}
+			continue
+		}
+
+		typeName := tags[TagName]
 
 		if typeName == "" {
 			typeName = typetostring.GetReflectType(fieldType)
 		}
 
+		if skip != "" && typeName == skip {
+			continue
+		}
+
 		if fieldType.Kind() == reflect.Func {
 			mapping, ok := c.factories[typeName]
 			if ok {
@@ -192,14 +299,40 @@ func (c *Container) InjectInto(ctx context.Context, target any) error {
 
 		err = c.injectByName(ctx, typeName, field)
 		if err != nil {
-			if errors.Is(err, ErrServiceNotFound) && !mustInject {
-				continue
+			if !errors.Is(err, ErrServiceNotFound) {
+				return err
+			}
+			if c.requiresInjection(tags, field) {
+				return fmt.Errorf("field '%s' of %s expects %s: %w", t.Field(i).Name, t, fieldType, err)
 			}
-			return err
 		}
 	}
 
-	return nil
+	return errors.Join(configErrs...)
+}
+
+// injectConfig injects the value found under the key by the registered ConfigProvider into the field.
+func (c *Container) injectConfig(ctx context.Context, key string, field reflect.StructField, value reflect.Value) error {
+	provider, err := c.InvokeByInterface(ctx, configProviderType)
+	if err != nil {
+		return fmt.Errorf("config field '%s' requires a ConfigProvider: %w", field.Name, err)
+	}
+
+	return provider.(ConfigProvider).InjectConfig(ctx, key, field, value)
+}
+
+// requiresInjection reports whether failing to resolve the field is an error: fields tagged with name must be injected,
+// in strict mode nil interface and pointer fields must be injected too, unless tagged as optional.
+func (c *Container) requiresInjection(tags map[Tag]string, field reflect.Value) bool {
+	if _, ok := tags[TagOptional]; ok {
+		return false
+	}
+	if _, ok := tags[TagName]; ok {
+		return true
+	}
+
+	kind := field.Kind()
+	return c.config().Strict && (kind == reflect.Interface || kind == reflect.Pointer) && field.IsNil()
 }
 
 func (c *Container) injectByInterface(ctx context.Context, field reflect.Value, fieldType reflect.Type) error {
@@ -213,6 +346,97 @@ func (c *Container) injectByInterface(ctx context.Context, field reflect.Value,
 	return nil
 }
 
+// injectLazy injects a resolver into a Lazy field, the service is initialized on demand when resolved.
+func (c *Container) injectLazy(name string, field reflect.Value) {
+	field.Addr().Interface().(lazyDependency).setLazyResolver(name, func(ctx context.Context) (any, error) {
+		service, ok := c.services[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s'", ErrServiceNotFound, name)
+		}
+
+		if err := c.initServiceOnDemand(ctx, c.decorated(service)); err != nil {
+			return nil, err
+		}
+
+		return c.Invoke(ctx, name)
+	})
+}
+
+// injectGroup injects instances of all members of the group into a slice field in registration order.
+func (c *Container) injectGroup(ctx context.Context, group string, field reflect.Value) error {
+	if field.Kind() != reflect.Slice {
+		return fmt.Errorf("%w: group '%s' can only be injected into a slice, got %s", ErrInvalidTag, group, field.Type())
+	}
+
+	members := c.groups[group]
+	elemType := field.Type().Elem()
+	instances := reflect.MakeSlice(field.Type(), 0, len(members))
+
+	for _, member := range members {
+		instance, err := c.Invoke(ctx, member.Name())
+		if err != nil {
+			return err
+		}
+
+		value := reflect.ValueOf(instance)
+		if !value.IsValid() || !value.Type().AssignableTo(elemType) {
+			return fmt.Errorf("%w: member '%s' of group '%s' is %T, expected %s", ErrServiceInvalid, member.Name(), group, instance, elemType)
+		}
+
+		instances = reflect.Append(instances, value)
+	}
+
+	field.Set(instances)
+
+	return nil
+}
+
+// injectServiceMap injects all named services implementing the map's value type into a map[string]I field,
+// keyed by service names. If pattern is not empty, only services with matching names are injected.
+func (c *Container) injectServiceMap(ctx context.Context, pattern string, field reflect.Value, target any) error {
+	fieldType := field.Type()
+	if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String || fieldType.Elem().Kind() != reflect.Interface {
+		return fmt.Errorf("%w: names can only be injected into a map[string]I, got %s", ErrInvalidTag, field.Type())
+	}
+
+	instances := reflect.MakeMap(fieldType)
+
+	for _, service := range c.namedServicesImplementing(fieldType.Elem(), pattern) {
+		instance, err := c.Invoke(ctx, service.Name())
+		if err != nil {
+			return err
+		}
+
+		// a service must not be injected into itself
+		if instance == target {
+			continue
+		}
+
+		instances.SetMapIndex(reflect.ValueOf(service.Name()), reflect.ValueOf(instance))
+	}
+
+	field.Set(instances)
+
+	return nil
+}
+
+// namedServicesImplementing returns all services registered with explicit names that implement the given interface
+// and whose names match the pattern. Services named after their types, services with generated names
+// (group members, runners, lists) and factories with arguments are never returned.
+func (c *Container) namedServicesImplementing(iface reflect.Type, pattern string) []ServiceDef {
+	matches := []ServiceDef{}
+	for _, service := range c.servicesImplementing(iface) {
+		if named, ok := service.(namedService); !ok || !named.explicitlyNamed() {
+			continue
+		}
+		if strings.HasPrefix(service.Name(), "$") || service.Arguments() > 0 || !matchName(pattern, service.Name()) {
+			continue
+		}
+		matches = append(matches, service)
+	}
+	return matches
+}
+
 func (c *Container) injectByName(ctx context.Context, name string, field reflect.Value) error {
 	dependency, err := c.Invoke(ctx, name)
 	if err != nil {
@@ -230,51 +454,117 @@ func (c *Container) injectByName(ctx context.Context, name string, field reflect
 func (c *Container) Shutdown(ctx context.Context) error {
 	c.logger.Debug("Shutting down all runners")
 
-	for _, service := range c.graph.TopologicalOrder() {
+	// Services are shut down as soon as all their dependents are shut down,
+	// independent services are shut down concurrently unless sequential shutdown is requested.
+	limit := 0
+	if c.config().SequentialShutdown {
+		limit = 1
+	}
+
+	// A failing service does not stop the shutdown, the remaining services are still shut down
+	// and all errors are collected.
+	err := walkGraph(ctx, c.graph, dependentsFirst, limit, false, func(ctx context.Context, service ServiceDef) error {
+		// Services that were never initialized, for instance because initialization failed, are not shut down.
+		if !c.isInitialized(service.Name()) {
+			return nil
+		}
+		c.setInitialized(service.Name(), false)
+
 		shutdowner, ok := service.(serviceShutdowner)
 		if !ok {
-			continue
+			return nil
 		}
 		if err := shutdowner.Shutdown(ctx); err != nil {
-			c.logger.Error("Failed to shutdown service. Exiting immediately", "service", service.Name(), "error", err)
-			return err
+			c.logger.Error("Failed to shutdown service, continuing", "service", service.Name(), "error", err)
+			return &ServiceError{Service: service.Name(), Phase: PhaseShutdown, Err: err}
 		}
+		return nil
+	})
+	if err != nil {
+		c.logger.Error("Container shut down with errors", "error", err)
+		return err
 	}
 
 	c.logger.Debug("Container shut down successfully")
 	return nil
 }
 
+// release shuts down the instance created by a tracked factory and stops tracking it.
+func (c *Container) release(ctx context.Context, instance any) error {
+	for _, service := range c.services {
+		releaser, ok := service.(instanceReleaser)
+		if !ok {
+			continue
+		}
+		if released, err := releaser.release(ctx, instance); released {
+			return err
+		}
+	}
+
+	return fmt.Errorf("%w: %T", ErrNotTracked, instance)
+}
+
+// HealthCheck health checks all services, it returns errors of unhealthy services joined, see [Container.HealthReport].
 func (c *Container) HealthCheck(ctx context.Context) error {
-	var wg errgroup.Group
+	return c.HealthReport(ctx).Err()
+}
 
+// HealthReport health checks all services having a health check concurrently and reports the result of every check.
+func (c *Container) HealthReport(ctx context.Context) HealthReport {
 	c.logger.Debug("Healthchecking services")
 
+	checkers := map[string]serviceHealthChecker{}
 	for _, service := range c.graph.TopologicalOrder() {
-		wg.Go(func() error {
-			// Do not check pal again, this leads to recursion
-			if service.Name() == palServiceName() {
-				return nil
-			}
+		// Do not check pal again, this leads to recursion
+		if service.Name() == palServiceName() {
+			continue
+		}
+		if checkable, ok := service.(healthCheckable); ok && !checkable.healthCheckable() {
+			continue
+		}
+		if checker, ok := service.(serviceHealthChecker); ok {
+			checkers[service.Name()] = checker
+		}
+	}
 
-			healthChecker, ok := service.(serviceHealthChecker)
-			if !ok {
-				return nil
-			}
+	names := slices.Sorted(maps.Keys(checkers))
+	report := HealthReport{Services: make([]ServiceHealth, len(names))}
 
-			return healthChecker.HealthCheck(ctx)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Go(func() {
+			report.Services[i] = c.checkHealth(ctx, name, checkers[name])
 		})
 	}
+	wg.Wait()
 
-	err := wg.Wait()
-	if err != nil {
+	if err := report.Err(); err != nil {
 		c.logger.Error("Healthcheck failed", "error", err)
-		return err
+	} else {
+		c.logger.Debug("Healthcheck successful")
 	}
 
-	c.logger.Debug("Healthcheck successful")
+	return report
+}
 
-	return nil
+// checkHealth health checks the service and records the time of the check if it succeeds.
+func (c *Container) checkHealth(ctx context.Context, name string, checker serviceHealthChecker) ServiceHealth {
+	start := time.Now()
+	err := checker.HealthCheck(ctx)
+	health := ServiceHealth{Name: name, Status: HealthStatusHealthy, Latency: time.Since(start)}
+
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+
+	if err != nil {
+		health.Status = HealthStatusUnhealthy
+		health.Err = &ServiceError{Service: name, Phase: PhaseHealthCheck, Err: err}
+	} else {
+		c.lastHealthy[name] = start
+	}
+	health.LastSuccess = c.lastHealthy[name]
+
+	return health
 }
 
 // Services returns a map of all registered services in the container, keyed by their names.
@@ -291,33 +581,221 @@ func (c *Container) StartRunners(ctx context.Context) error {
 	return RunServices(ctx, services)
 }
 
+// LazyEdges returns dependencies injected as [Lazy], keyed by the dependent service name.
+// They are not part of [Container.Graph] as they don't affect initialization order.
+func (c *Container) LazyEdges() map[string]map[string]bool {
+	return c.lazyEdges
+}
+
 // Graph returns the live dependency graph of services.
 // This can be useful for visualization, analysis, or advanced mutation via DAG methods.
 func (c *Container) Graph() *ServiceGraph {
 	return c.graph
 }
 
+// initService initializes the service exactly once. Concurrent and subsequent calls wait for the first one
+// and return its result.
+func (c *Container) initService(ctx context.Context, service ServiceDef) error {
+	c.stateMu.Lock()
+	state, ok := c.initStates[service.Name()]
+	if ok {
+		c.stateMu.Unlock()
+
+		select {
+		case <-state.done:
+			return state.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	state = &initState{done: make(chan struct{})}
+	c.initStates[service.Name()] = state
+	c.stateMu.Unlock()
+
+	defer close(state.done)
+
+	if initer, ok := service.(serviceIniter); ok {
+		if err := initer.Init(ctx); err != nil {
+			state.err = &ServiceError{Service: service.Name(), Phase: PhaseInit, Err: err}
+			return state.err
+		}
+	}
+
+	c.setInitialized(service.Name(), true)
+	return nil
+}
+
+// initServiceOnDemand initializes the service along with its dependencies, dependencies being initialized by other
+// goroutines are awaited. Services being initialized by the caller's own init chain are skipped, as they are
+// waiting for this service, which means the services form a cycle.
+func (c *Container) initServiceOnDemand(ctx context.Context, service ServiceDef) error {
+	if inInitChain(ctx, service.Name()) {
+		return nil
+	}
+
+	for dependency := range c.graph.Edges()[service.Name()] {
+		dependencyService, ok := c.graph.GetVertex(dependency)
+		if !ok {
+			continue
+		}
+
+		if err := c.initServiceOnDemand(ctx, dependencyService); err != nil {
+			return err
+		}
+	}
+
+	return c.initService(ctx, service)
+}
+
+// withInitChain returns a copy of ctx recording that the service is being initialized by the current call chain,
+// ctx is returned as is if the chain already records the service.
+func withInitChain(ctx context.Context, name string) context.Context {
+	chain, _ := ctx.Value(ctxInitChain).([]string)
+	if slices.Contains(chain, name) {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxInitChain, append(slices.Clip(chain), name))
+}
+
+// inInitChain reports whether the service is being initialized by the call chain ctx belongs to.
+func inInitChain(ctx context.Context, name string) bool {
+	chain, _ := ctx.Value(ctxInitChain).([]string)
+	return slices.Contains(chain, name)
+}
+
+func (c *Container) setInitialized(name string, initialized bool) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+
+	if initialized {
+		c.initialized[name] = true
+	} else {
+		delete(c.initialized, name)
+	}
+}
+
+func (c *Container) isInitialized(name string) bool {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+
+	return c.initialized[name]
+}
+
+// config returns a copy of the owning Pal's config, or an empty config when the container is used standalone.
+func (c *Container) config() Config {
+	if c.pal == nil || c.pal.config == nil {
+		return Config{}
+	}
+	return *c.pal.config
+}
+
 func (c *Container) addService(service ServiceDef) {
 	setPalField(reflect.ValueOf(service), c.pal, map[reflect.Value]bool{})
 	c.services[service.Name()] = service
+
+	if member, ok := service.(groupMember); ok && member.groupName() != "" {
+		c.groups[member.groupName()] = append(c.groups[member.groupName()], service)
+	}
+
+	if d, ok := service.(decorator); ok {
+		c.decorators[d.decoratedName()] = append(c.decorators[d.decoratedName()], service)
+	}
+}
+
+// linkDecorators chains decorators of each service in registration order: the first decorator wraps the service,
+// each next one wraps the previous one.
+func (c *Container) linkDecorators() error {
+	if err := c.checkDecorators(); err != nil {
+		return err
+	}
+
+	for name, decorators := range c.decorators {
+		inner := c.services[name]
+		for _, d := range decorators {
+			d.(decorator).setDecorated(inner)
+			inner = d
+		}
+	}
+
+	return nil
+}
+
+// checkDecorators checks that every decorated service exists and is a singleton, only singletons can be decorated.
+func (c *Container) checkDecorators() error {
+	for name := range c.decorators {
+		service, ok := c.services[name]
+		if !ok {
+			return fmt.Errorf("%w: '%s' cannot be decorated", ErrServiceNotFound, name)
+		}
+
+		if _, ok := service.(serviceIniter); !ok || service.Arguments() > 0 {
+			return fmt.Errorf("%w: '%s' cannot be decorated, only singletons can be decorated", ErrServiceInvalid, name)
+		}
+	}
+
+	return nil
 }
 
-// addDependencyVertex adds a service to the dependency graph and recursively adds its dependencies.
+// decorated returns the outermost decorator of the service, or the service itself if it is not decorated.
+func (c *Container) decorated(service ServiceDef) ServiceDef {
+	decorators := c.decorators[service.Name()]
+	if len(decorators) == 0 {
+		return service
+	}
+	return decorators[len(decorators)-1]
+}
+
+// addDependencyVertex adds a service to the given dependency graph and recursively adds its dependencies.
 // If parent is not nil, it also adds an edge from parent to service in the graph.
+// Dependencies injected as Lazy are recorded in lazyEdges instead of the graph.
 // This method is used during container initialization to build the complete dependency graph.
-func (c *Container) addDependencyVertex(service ServiceDef, parent ServiceDef) error {
-	c.graph.AddVertexIfNotExist(service.Name(), service)
+func (c *Container) addDependencyVertex(graph *ServiceGraph, lazyEdges map[string]map[string]bool, service ServiceDef, parent ServiceDef) error {
+	graph.AddVertexIfNotExist(service.Name(), service)
 
 	if parent != nil {
-		if err := c.graph.AddEdgeIfNotExist(parent.Name(), service.Name()); err != nil {
+		if err := graph.AddEdgeIfNotExist(parent.Name(), service.Name()); err != nil {
 			return err
 		}
 	}
-	m := service.Make()
-	if isNil(m) {
+	var decoratedName string
+	if d, ok := service.(decorator); ok {
+		// A decorator wraps the previous decorator or the service itself, so it must be initialized after it.
+		decoratedName = d.decoratedName()
+
+		inner := c.services[decoratedName]
+		if index := slices.Index(c.decorators[decoratedName], service); index > 0 {
+			inner = c.decorators[decoratedName][index-1]
+		}
+		if err := c.addDependencyVertex(graph, lazyEdges, inner, service); err != nil {
+			return err
+		}
+	}
+
+	templates := []any{service.Make()}
+	if p, ok := service.(parameterized); ok {
+		// Parameters are resolved before the service is created, so they must be initialized first.
+		for _, template := range p.parameterTemplates() {
+			templates = append(templates, template.instance)
+		}
+	}
+
+	for _, template := range templates {
+		if err := c.addFieldDependencies(graph, lazyEdges, service, template, decoratedName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addFieldDependencies adds dependencies resolved by injecting into the exported fields of the template
+// to the graph as dependencies of the service.
+func (c *Container) addFieldDependencies(graph *ServiceGraph, lazyEdges map[string]map[string]bool, service ServiceDef, template any, decoratedName string) error {
+	if isNil(template) {
 		return nil
 	}
-	val := reflect.ValueOf(m)
+	val := reflect.ValueOf(template)
 	if val.Kind() == reflect.Pointer {
 		val = val.Elem()
 	}
@@ -327,6 +805,16 @@ func (c *Container) addDependencyVertex(service ServiceDef, parent ServiceDef) e
 	}
 
 	typ := val.Type()
+
+	if injector, ok := injectorFor(typ); ok {
+		for _, field := range injector.fields {
+			if err := c.addDependency(graph, lazyEdges, service, field.Dependency, decoratedName); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
 
@@ -339,22 +827,97 @@ func (c *Container) addDependencyVertex(service ServiceDef, parent ServiceDef) e
 			return err
 		}
 
+		if isLazyField(field.Type) {
+			// Lazy dependencies do not affect initialization order, they are only recorded to be displayed.
+			dependencyName := lazyFieldDependencyName(field.Type, tags)
+			if _, ok := c.services[dependencyName]; ok {
+				if lazyEdges[service.Name()] == nil {
+					lazyEdges[service.Name()] = map[string]bool{}
+				}
+				lazyEdges[service.Name()][dependencyName] = true
+			}
+			continue
+		}
+
+		if _, ok := tags[TagConfig]; ok {
+			// The config provider loads the configuration during initialization, so it must be initialized first.
+			for _, provider := range c.servicesImplementing(configProviderType) {
+				if provider.Name() == service.Name() {
+					continue
+				}
+				if err := c.addDependencyVertex(graph, lazyEdges, c.decorated(provider), service); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if _, ok := tags[TagMatchInterface]; ok && field.Type.Kind() == reflect.Interface {
+			// The service is resolved by interface during injection, so it must be initialized first.
+			for _, childService := range c.servicesImplementing(field.Type) {
+				if childService.Name() == service.Name() {
+					continue
+				}
+				if err := c.addDependencyVertex(graph, lazyEdges, c.decorated(childService), service); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if group, ok := tags[TagGroup]; ok {
+			// All members of the group are injected, so all of them must be initialized first.
+			for _, member := range c.groups[group] {
+				if err := c.addDependencyVertex(graph, lazyEdges, c.decorated(member), service); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if pattern, ok := tags[TagNames]; ok && field.Type.Kind() == reflect.Map {
+			// All matching services are injected, so all of them must be initialized first.
+			for _, childService := range c.namedServicesImplementing(field.Type.Elem(), pattern) {
+				if childService.Name() == service.Name() {
+					continue
+				}
+				if err := c.addDependencyVertex(graph, lazyEdges, c.decorated(childService), service); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
 		dependencyName := tags[TagName]
 
 		if dependencyName == "" {
 			dependencyName = typetostring.GetReflectType(field.Type)
 		}
 
-		if childService, ok := c.services[dependencyName]; ok {
-			if err := c.addDependencyVertex(childService, service); err != nil {
-				return err
-			}
+		if err := c.addDependency(graph, lazyEdges, service, dependencyName, decoratedName); err != nil {
+			return err
 		}
+	}
 
-		if factoryMapping, ok := c.factories[dependencyName]; ok {
-			if err := c.addDependencyVertex(factoryMapping.Service, service); err != nil {
-				return err
-			}
+	return nil
+}
+
+// addDependency adds the service or factory named dependencyName to the graph as a dependency of the service.
+func (c *Container) addDependency(graph *ServiceGraph, lazyEdges map[string]map[string]bool, service ServiceDef, dependencyName string, decoratedName string) error {
+	// The decorated instance is passed to the decorator, it is not injected.
+	if dependencyName == decoratedName {
+		return nil
+	}
+
+	if childService, ok := c.services[dependencyName]; ok {
+		if err := c.addDependencyVertex(graph, lazyEdges, c.decorated(childService), service); err != nil {
+			return err
+		}
+	}
+
+	if factoryMapping, ok := c.factories[dependencyName]; ok {
+		if err := c.addDependencyVertex(graph, lazyEdges, factoryMapping.Service, service); err != nil {
+			return err
 		}
 	}
 
@@ -362,12 +925,17 @@ func (c *Container) addDependencyVertex(service ServiceDef, parent ServiceDef) e
 }
 
 func (c *Container) injectLoggerIntoField(field reflect.Value, target any) {
+	field.Set(reflect.ValueOf(c.loggerFor(target)))
+}
+
+// loggerFor returns the default logger with attributes set by the configured attribute setters for the target.
+func (c *Container) loggerFor(target any) *slog.Logger {
 	logger := slog.Default()
 	for _, attrSetter := range c.pal.config.AttrSetters {
 		name, value := attrSetter(target)
 		logger = logger.With(name, value)
 	}
-	field.Set(reflect.ValueOf(logger))
+	return logger
 }
 
 func setPalField(v reflect.Value, pal *Pal, visited map[reflect.Value]bool) {
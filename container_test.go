@@ -254,6 +254,37 @@ func TestContainer_Shutdown(t *testing.T) {
 
 		assert.ErrorIs(t, err, errTest)
 	})
+
+	t.Run("continues shutting down after a failing service and reports all failures", func(t *testing.T) {
+		t.Parallel()
+
+		service1 := NewMockLifecycleService(t, "service1")
+		service2 := NewMockLifecycleService(t, "service2")
+		service3 := NewMockLifecycleService(t, "service3")
+
+		service1.MockIniter.EXPECT().Init(t.Context()).Return(nil)
+		service2.MockIniter.EXPECT().Init(t.Context()).Return(nil)
+		service3.MockIniter.EXPECT().Init(t.Context()).Return(nil)
+
+		service1.MockShutdowner.EXPECT().Shutdown(t.Context()).Return(errTest)
+		service2.MockShutdowner.EXPECT().Shutdown(t.Context()).Return(errTest2)
+		service3.MockShutdowner.EXPECT().Shutdown(t.Context()).Return(nil)
+
+		c := pal.NewContainer(&pal.Pal{}, service1, service2, service3)
+		require.NoError(t, c.Init(t.Context()))
+
+		err := c.Shutdown(t.Context())
+
+		assert.ErrorIs(t, err, errTest)
+		assert.ErrorIs(t, err, errTest2)
+
+		var serviceErr *pal.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		assert.Equal(t, pal.PhaseShutdown, serviceErr.Phase)
+		assert.Contains(t, []string{"service1", "service2"}, serviceErr.Service)
+		assert.ErrorContains(t, err, "shutdown of service 'service1' failed: test error")
+		assert.ErrorContains(t, err, "shutdown of service 'service2' failed: test error 2")
+	})
 }
 
 // TestContainer_HealthCheck tests the HealthCheck method of Container
@@ -2,6 +2,7 @@ package pal
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Error variables used throughout the package
@@ -36,13 +37,68 @@ var (
 	// ErrInvokerIsNotInContext is returned when a context passed to Invoke does not contain a Pal instance.
 	ErrInvokerIsNotInContext = errors.New("invoker is not in context")
 
+	// ErrScopeIsNotInContext is returned when a scoped service is invoked with a context that does not contain a Scope.
+	ErrScopeIsNotInContext = errors.New("scope is not in context")
+
+	// ErrScopeClosed is returned when a scoped service is invoked within a closed scope.
+	ErrScopeClosed = errors.New("scope is closed")
+
+	// ErrLazyNotInjected is returned when Get is called on a Lazy dependency that was not injected by Pal.
+	ErrLazyNotInjected = errors.New("lazy dependency is not injected")
+
 	// ErrInvalidTag is returned when a tag is invalid.
 	ErrInvalidTag = errors.New("invalid tag")
 
 	// ErrNotAnInterface is returned when a type is not an interface.
 	ErrNotAnInterface = errors.New("not an interface")
+
+	// ErrConfigKeyNotFound is returned by a ConfigProvider when there is no value under the requested key.
+	ErrConfigKeyNotFound = errors.New("config key not found")
+
+	// ErrConfigInvalid is returned by a ConfigProvider when a value cannot be decoded into a field or fails validation.
+	ErrConfigInvalid = errors.New("config value invalid")
+
+	// ErrInvalidFunction is returned when a function passed to Call has an unsupported signature.
+	ErrInvalidFunction = errors.New("invalid function")
+
+	// ErrNotTracked is returned by Release when the instance was not created by a tracked factory or is already released.
+	ErrNotTracked = errors.New("instance is not tracked")
+
+	// ErrPoolExhausted is returned when an instance cannot be acquired from a pool as all its instances are in use.
+	ErrPoolExhausted = errors.New("pool exhausted")
+
+	// ErrPoolClosed is returned when an instance is acquired from a pool that is shut down.
+	ErrPoolClosed = errors.New("pool closed")
+
+	// ErrServiceClosed is returned when an instance is invoked from a keyed service that is shut down.
+	ErrServiceClosed = errors.New("service closed")
 )
 
+// Phase is a service lifecycle phase reported in [ServiceError].
+type Phase string
+
+const (
+	PhaseInit        Phase = "init"
+	PhaseShutdown    Phase = "shutdown"
+	PhaseHealthCheck Phase = "healthcheck"
+)
+
+// ServiceError is returned when a service fails in one of the lifecycle phases.
+// When several services fail, the errors are joined with [errors.Join], use [errors.As] to inspect them.
+type ServiceError struct {
+	Service string
+	Phase   Phase
+	Err     error
+}
+
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("%s of service '%s' failed: %s", e.Phase, e.Service, e.Err)
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
 type PanicError struct {
 	error
 	backtrace string
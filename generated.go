@@ -0,0 +1,129 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+
+	typetostring "github.com/samber/go-type-to-string"
+)
+
+// InjectedField describes a field assigned by a generated injector.
+type InjectedField struct {
+	// Name is the name of the field.
+	Name string
+	// Dependency is the name of the service or factory the field is resolved to.
+	Dependency string
+	// Named is true if the field is tagged with name, such fields must be injected.
+	Named bool
+	// Optional is true if the field is tagged with optional.
+	Optional bool
+}
+
+// Injector is a reflection-free injector of dependencies into structs of type T. Injectors are generated by
+// `pal gen` and registered with [RegisterInjector] from init functions of the generated files.
+type Injector[T any] struct {
+	// Fields describes fields resolved to services and factories, they are used to build the dependency graph.
+	Fields []InjectedField
+	// Inject assigns dependencies to the fields of target.
+	Inject func(ctx context.Context, c *Container, target *T) error
+}
+
+// generatedInjector is a type-erased Injector.
+type generatedInjector struct {
+	fields []InjectedField
+	inject func(ctx context.Context, c *Container, target any) error
+}
+
+var (
+	injectors   = map[reflect.Type]generatedInjector{}
+	injectorsMu sync.RWMutex
+)
+
+// RegisterInjector registers a generated injector for T. Containers use it instead of reflection to inject
+// dependencies into *T and to find its dependencies, types without an injector are handled with reflection.
+// It is meant to be called only by generated code.
+func RegisterInjector[T any](injector Injector[T]) {
+	injectorsMu.Lock()
+	defer injectorsMu.Unlock()
+
+	injectors[reflect.TypeOf((*T)(nil)).Elem()] = generatedInjector{
+		fields: injector.Fields,
+		inject: func(ctx context.Context, c *Container, target any) error {
+			return injector.Inject(ctx, c, target.(*T))
+		},
+	}
+}
+
+func injectorFor(t reflect.Type) (generatedInjector, bool) {
+	injectorsMu.RLock()
+	defer injectorsMu.RUnlock()
+
+	injector, ok := injectors[t]
+	return injector, ok
+}
+
+// ServiceName returns the name services of type T are registered under by [Provide] and similar functions,
+// it is the name untagged fields of type T are resolved to.
+func ServiceName[T any]() string {
+	return typetostring.GetType[T]()
+}
+
+// InjectService resolves the service described by spec and assigns it to the field of target,
+// following the rules of untagged and name-tagged fields. It is meant to be called only by generated code.
+func InjectService[T any, F any](ctx context.Context, c *Container, target *T, field *F, spec InjectedField) error {
+	dependency, err := c.Invoke(ctx, spec.Dependency)
+	if err != nil {
+		if errors.Is(err, ErrServiceInvalidArgumentsCount) {
+			err = fmt.Errorf("%w: '%s': %w", ErrFactoryServiceDependency, spec.Dependency, err)
+		}
+		if !errors.Is(err, ErrServiceNotFound) {
+			return err
+		}
+		if c.requiresGeneratedInjection(spec, reflect.ValueOf(field).Elem()) {
+			return fmt.Errorf("field '%s' of %T expects %T: %w", spec.Name, *target, *field, err)
+		}
+		return nil
+	}
+
+	value, ok := dependency.(F)
+	if !ok {
+		return fmt.Errorf("%w: '%s' is %T, field '%s' of %T expects %T", ErrServiceInvalid, spec.Dependency, dependency, spec.Name, *target, *field)
+	}
+
+	*field = value
+	return nil
+}
+
+// InjectFactory assigns the factory function described by spec to the field if it is registered.
+// It is meant to be called only by generated code.
+func InjectFactory[F any](c *Container, field *F, spec InjectedField) {
+	if mapping, ok := c.factories[spec.Dependency]; ok {
+		*field = mapping.Factory.(F)
+	}
+}
+
+// InjectLogger assigns a logger with attributes set by the configured attribute setters to the field of target.
+// If no attribute setters are configured, the field is resolved like any other field with [InjectService].
+// It is meant to be called only by generated code.
+func InjectLogger[T any](ctx context.Context, c *Container, target *T, field **slog.Logger, spec InjectedField) error {
+	if c.pal.config.AttrSetters != nil {
+		*field = c.loggerFor(target)
+		return nil
+	}
+	return InjectService(ctx, c, target, field, spec)
+}
+
+func (c *Container) requiresGeneratedInjection(spec InjectedField, field reflect.Value) bool {
+	tags := map[Tag]string{}
+	if spec.Optional {
+		tags[TagOptional] = ""
+	}
+	if spec.Named {
+		tags[TagName] = spec.Dependency
+	}
+	return c.requiresInjection(tags, field)
+}
@@ -0,0 +1,162 @@
+package pal_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+// generatedConsumer has an injector registered the same way files generated by `pal gen` do.
+type generatedConsumer struct {
+	Pinger  Pinger
+	Named   Pinger `pal:"name=generated-pinger"`
+	Factory func(ctx context.Context) (*factory1Service, error)
+	Logger  *slog.Logger
+
+	pinged bool
+}
+
+func (c *generatedConsumer) Init(_ context.Context) error {
+	c.pinged = c.Pinger != nil
+	return nil
+}
+
+// generatedLoggerConsumer is the generated counterpart of strictLoggerConsumer.
+type generatedLoggerConsumer struct {
+	Logger *slog.Logger
+}
+
+// generatedInjections counts calls of the generatedConsumer injector.
+var generatedInjections atomic.Int32
+
+func init() {
+	fields := []pal.InjectedField{
+		{Name: "Pinger", Dependency: pal.ServiceName[Pinger]()},
+		{Name: "Named", Dependency: "generated-pinger", Named: true},
+		{Name: "Factory", Dependency: pal.ServiceName[func(ctx context.Context) (*factory1Service, error)]()},
+		{Name: "Logger", Dependency: pal.ServiceName[*slog.Logger]()},
+	}
+
+	pal.RegisterInjector(pal.Injector[generatedConsumer]{
+		Fields: fields,
+		Inject: func(ctx context.Context, c *pal.Container, target *generatedConsumer) error {
+			generatedInjections.Add(1)
+
+			if err := pal.InjectService(ctx, c, target, &target.Pinger, fields[0]); err != nil {
+				return err
+			}
+			if err := pal.InjectService(ctx, c, target, &target.Named, fields[1]); err != nil {
+				return err
+			}
+			pal.InjectFactory(c, &target.Factory, fields[2])
+			return pal.InjectLogger(ctx, c, target, &target.Logger, fields[3])
+		},
+	})
+
+	loggerFields := []pal.InjectedField{
+		{Name: "Logger", Dependency: pal.ServiceName[*slog.Logger]()},
+	}
+
+	pal.RegisterInjector(pal.Injector[generatedLoggerConsumer]{
+		Fields: loggerFields,
+		Inject: func(ctx context.Context, c *pal.Container, target *generatedLoggerConsumer) error {
+			return pal.InjectLogger(ctx, c, target, &target.Logger, loggerFields[0])
+		},
+	})
+}
+
+// TestContainer_GeneratedInjector tests that registered injectors are used instead of reflection.
+func TestContainer_GeneratedInjector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("injects dependencies with the generated injector", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &generatedConsumer{}
+		pinger := &Pinger1{}
+
+		p := newPal(
+			pal.Provide(consumer),
+			pal.ProvideNamed[Pinger]("generated-pinger", &Pinger2{}),
+			pal.Provide[Pinger](pinger),
+			pal.ProvideFactory0[*factory1Service](func(_ context.Context) (*factory1Service, error) {
+				return &factory1Service{}, nil
+			}),
+		)
+
+		before := generatedInjections.Load()
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Greater(t, generatedInjections.Load(), before)
+		assert.Same(t, pinger, consumer.Pinger)
+		assert.IsType(t, &Pinger2{}, consumer.Named)
+		assert.NotNil(t, consumer.Factory)
+		assert.Nil(t, consumer.Logger)
+		assert.True(t, consumer.pinged, "dependencies must be initialized before the consumer")
+	})
+
+	t.Run("builds the dependency graph from the injector fields", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&generatedConsumer{}),
+			pal.ProvideNamed[Pinger]("generated-pinger", &Pinger2{}),
+			pal.Provide[Pinger](&Pinger1{}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+
+		consumerName := pal.ServiceName[*generatedConsumer]()
+		assert.True(t, p.Container().Graph().EdgeExists(consumerName, "generated-pinger"))
+		assert.True(t, p.Container().Graph().EdgeExists(consumerName, pal.ServiceName[Pinger]()))
+	})
+
+	t.Run("returns an error when a named dependency is missing", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&generatedConsumer{}))
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, pal.ErrServiceNotFound)
+		assert.ErrorContains(t, err, "field 'Named'")
+	})
+
+	t.Run("returns an error when a dependency has an unexpected type", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&generatedConsumer{}),
+			pal.ProvideNamed("generated-pinger", &factory1Service{}),
+		)
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, pal.ErrServiceInvalid)
+	})
+	t.Run("resolves loggers like the reflection injector", func(t *testing.T) {
+		t.Parallel()
+
+		reflectedErr := newPal(pal.Provide(&strictLoggerConsumer{})).Strict().Init(t.Context())
+		generatedErr := newPal(pal.Provide(&generatedLoggerConsumer{})).Strict().Init(t.Context())
+
+		require.ErrorIs(t, reflectedErr, pal.ErrServiceNotFound)
+		require.ErrorIs(t, generatedErr, pal.ErrServiceNotFound)
+		assert.ErrorContains(t, generatedErr, "field 'Logger'")
+
+		reflected := &strictLoggerConsumer{}
+		generated := &generatedLoggerConsumer{}
+
+		p := newPal(pal.Provide(reflected), pal.Provide(generated)).Strict().InjectSlog()
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.NotNil(t, reflected.Logger)
+		assert.NotNil(t, generated.Logger)
+	})
+}
@@ -0,0 +1,108 @@
+package pal
+
+import (
+	"context"
+	"errors"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// walkDirection tells walkGraph which end of a dependency edge has to be visited first.
+type walkDirection int
+
+const (
+	// dependenciesFirst visits a service only after all its dependencies are visited. Used for initialization.
+	dependenciesFirst walkDirection = iota
+	// dependentsFirst visits a service only after all services depending on it are visited. Used for shutdown.
+	dependentsFirst
+)
+
+// walkResult is reported by a visit goroutine back to the walkGraph scheduler.
+type walkResult struct {
+	id  string
+	err error
+}
+
+// walkGraph calls visit for every service in the graph respecting the dependency order given by direction.
+// A service is visited as soon as all the services it waits for are visited, independent services are visited
+// concurrently, at most limit at a time. limit <= 0 means no limit.
+// If stopOnError is true, no new visits are started after the first failure, visits that are already in flight
+// are awaited. Otherwise, a failed visit is treated as finished and the walk continues.
+// All errors returned by visit are joined and returned.
+func walkGraph(
+	ctx context.Context,
+	graph *ServiceGraph,
+	direction walkDirection,
+	limit int,
+	stopOnError bool,
+	visit func(ctx context.Context, service ServiceDef) error,
+) error {
+	vertices := graph.Vertices()
+
+	waitsFor := make(map[string]int, len(vertices))
+	unblocks := make(map[string][]string, len(vertices))
+
+	for from, targets := range graph.Edges() {
+		for to := range targets {
+			// an edge from -> to means that "from" depends on "to"
+			if direction == dependenciesFirst {
+				waitsFor[from]++
+				unblocks[to] = append(unblocks[to], from)
+			} else {
+				waitsFor[to]++
+				unblocks[from] = append(unblocks[from], to)
+			}
+		}
+	}
+
+	var group errgroup.Group
+	if limit > 0 {
+		group.SetLimit(limit)
+	}
+
+	// Buffered so visit goroutines never block on reporting, even when the scheduler waits for a free slot.
+	results := make(chan walkResult, len(vertices))
+
+	start := func(id string) {
+		service := vertices[id]
+		group.Go(func() error {
+			err := visit(ctx, service)
+			results <- walkResult{id: id, err: err}
+			return nil
+		})
+	}
+
+	inFlight := 0
+	for id := range vertices {
+		if waitsFor[id] == 0 {
+			start(id)
+			inFlight++
+		}
+	}
+
+	var errs []error
+	for inFlight > 0 {
+		result := <-results
+		inFlight--
+
+		if result.err != nil {
+			errs = append(errs, result.err)
+		}
+
+		if len(errs) > 0 && stopOnError {
+			continue
+		}
+
+		for _, id := range unblocks[result.id] {
+			waitsFor[id]--
+			if waitsFor[id] == 0 {
+				start(id)
+				inFlight++
+			}
+		}
+	}
+
+	group.Wait() //nolint:errcheck // visit errors are reported via results
+
+	return errors.Join(errs...)
+}
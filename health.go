@@ -0,0 +1,67 @@
+package pal
+
+import (
+	"errors"
+	"time"
+)
+
+// HealthStatus is the health status of a service reported in [ServiceHealth].
+type HealthStatus string
+
+const (
+	HealthStatusHealthy   HealthStatus = "healthy"
+	HealthStatusUnhealthy HealthStatus = "unhealthy"
+)
+
+// ServiceHealth is the result of the health check of a single service.
+type ServiceHealth struct {
+	Name   string
+	Status HealthStatus
+	// Err is a [ServiceError] with [PhaseHealthCheck] wrapping the error returned by the check, nil if the service is healthy.
+	Err error
+	// Latency is the time the check took.
+	Latency time.Duration
+	// LastSuccess is the time the service was last reported healthy, zero if it has never been.
+	LastSuccess time.Time
+}
+
+// HealthReport is the result of health checks of all services having a health check.
+type HealthReport struct {
+	// Services holds results of the checks sorted by service name.
+	Services []ServiceHealth
+}
+
+// Healthy reports whether all services are healthy.
+func (r HealthReport) Healthy() bool {
+	return r.Err() == nil
+}
+
+// Err returns errors of unhealthy services joined with [errors.Join], nil if all services are healthy.
+func (r HealthReport) Err() error {
+	errs := []error{}
+	for _, service := range r.Services {
+		if service.Err != nil {
+			errs = append(errs, service.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// healthCheckable is implemented by service definitions that know whether their health check does anything,
+// definitions that do not implement it are checked if they implement HealthCheck.
+type healthCheckable interface {
+	healthCheckable() bool
+}
+
+// hasHealthCheck reports whether the instance is health checked by the hook or by its own method.
+func hasHealthCheck[T any](instance T, hook LifecycleHook[T]) bool {
+	if hook != nil {
+		return true
+	}
+	switch any(instance).(type) {
+	case PalHealthChecker, HealthChecker:
+		return true
+	default:
+		return false
+	}
+}
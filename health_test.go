@@ -0,0 +1,112 @@
+package pal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type reportedService struct {
+	err   error
+	delay time.Duration
+}
+
+func (s *reportedService) HealthCheck(_ context.Context) error {
+	time.Sleep(s.delay)
+	return s.err
+}
+
+func TestPal_HealthReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports every service having a health check", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.ProvideNamed("b", &reportedService{err: errTest, delay: 10 * time.Millisecond}),
+			pal.ProvideNamed("a", &reportedService{}),
+			pal.Provide(&Pinger1{}),
+			pal.Provide(&Pinger2{}).ToHealthCheck(func(_ context.Context, _ *Pinger2, _ pal.Invoker) error {
+				return errTest2
+			}),
+		)
+		require.NoError(t, p.Init(t.Context()))
+
+		report := p.HealthReport(t.Context())
+
+		require.Len(t, report.Services, 3)
+		assert.False(t, report.Healthy())
+
+		pinger, a, b := report.Services[0], report.Services[1], report.Services[2]
+
+		assert.Equal(t, "a", a.Name)
+		assert.Equal(t, pal.HealthStatusHealthy, a.Status)
+		assert.NoError(t, a.Err)
+		assert.False(t, a.LastSuccess.IsZero())
+
+		assert.Equal(t, "b", b.Name)
+		assert.Equal(t, pal.HealthStatusUnhealthy, b.Status)
+		assert.True(t, b.LastSuccess.IsZero())
+		assert.GreaterOrEqual(t, b.Latency, 10*time.Millisecond)
+
+		var serviceErr *pal.ServiceError
+		require.ErrorAs(t, b.Err, &serviceErr)
+		assert.Equal(t, "b", serviceErr.Service)
+		assert.Equal(t, pal.PhaseHealthCheck, serviceErr.Phase)
+		assert.ErrorIs(t, b.Err, errTest)
+
+		assert.Equal(t, pal.ServiceName[*Pinger2](), pinger.Name)
+		assert.ErrorIs(t, pinger.Err, errTest2)
+
+		err := p.HealthCheck(t.Context())
+		require.ErrorIs(t, err, errTest)
+		require.ErrorIs(t, err, errTest2)
+	})
+
+	t.Run("keeps the time of the last success", func(t *testing.T) {
+		t.Parallel()
+
+		service := &reportedService{}
+		p := newPal(pal.Provide(service))
+		require.NoError(t, p.Init(t.Context()))
+
+		healthy := p.HealthReport(t.Context())
+		require.True(t, healthy.Healthy())
+		require.NoError(t, healthy.Err())
+
+		service.err = errTest
+		unhealthy := p.HealthReport(t.Context())
+
+		require.False(t, unhealthy.Healthy())
+		assert.Equal(t, healthy.Services[0].LastSuccess, unhealthy.Services[0].LastSuccess)
+		assert.True(t, errors.Is(unhealthy.Err(), errTest))
+	})
+
+	t.Run("reports pooled and keyed services only if their instances have a health check", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.ProvidePool[*reportedService](func(_ context.Context) (*reportedService, error) {
+				return &reportedService{}, nil
+			}, 1),
+			pal.ProvidePool[*Pinger1](func(_ context.Context) (*Pinger1, error) {
+				return &Pinger1{}, nil
+			}, 1),
+			pal.ProvideKeyed[*Pinger2](func(_ context.Context, _ string) (*Pinger2, error) {
+				return &Pinger2{}, nil
+			}),
+		)
+		require.NoError(t, p.Init(t.Context()))
+
+		report := p.HealthReport(t.Context())
+
+		require.Len(t, report.Services, 1)
+		assert.Equal(t, pal.ServiceName[*reportedService](), report.Services[0].Name)
+	})
+}
@@ -126,6 +126,14 @@ function applyNodeStyle(node) {
 
 }
 
+function applyEdgeStyle(edge) {
+    // lazy dependencies do not affect initialization order
+    if (edge.kind === "lazy") {
+        edge.dashes = true;
+        edge.title = "lazy";
+    }
+}
+
 (async () => {
     // Initialize textarea with default options
     const textarea = document.getElementById("options-textarea");
@@ -136,6 +144,7 @@ function applyNodeStyle(node) {
     sortNodes(nodes);
 
     nodes.forEach(applyNodeStyle);
+    edges.forEach(applyEdgeStyle);
 
     // Set up event listener for textarea changes
     textarea.addEventListener('input', () => updateOptions(nodes, edges));
@@ -3,6 +3,7 @@ package pal
 import (
 	"context"
 	"reflect"
+	"time"
 )
 
 // RunConfiger is an optional interface a runner may implement to tell Pal whether to wait for it.
@@ -59,6 +60,71 @@ type ServiceDef interface {
 	Dependencies() []ServiceDef
 }
 
+// FactoryDef is the definition of a factory service, returned by ProvideFactory* functions.
+type FactoryDef interface {
+	ServiceDef
+
+	// Tracked makes Pal remember instances created by the factory until they are released with [Release].
+	// Instances that are not released are shut down in reverse creation order during shutdown,
+	// before the dependencies of the factory. Instances of untracked factories are never shut down.
+	Tracked() FactoryDef
+
+	// WithInitTimeout and WithShutdownTimeout bound initialization and shutdown of every created instance.
+	// The timeouts set in [Config] remain the upper bound for the whole phase.
+	WithInitTimeout(timeout time.Duration) FactoryDef
+	WithShutdownTimeout(timeout time.Duration) FactoryDef
+
+	// WithInitRetry retries creation and initialization of every instance according to the policy.
+	WithInitRetry(policy RetryPolicy) FactoryDef
+}
+
+// ConstructorDef is the definition of a service built with a constructor, returned by ProvideConstructor functions.
+type ConstructorDef interface {
+	ServiceDef
+
+	// WithInitTimeout, WithShutdownTimeout and WithHealthCheckTimeout bound the lifecycle phases of the service,
+	// the init timeout covers resolution of the parameters and the constructor call.
+	// The timeouts set in [Config] remain the upper bound for the whole phase.
+	WithInitTimeout(timeout time.Duration) ConstructorDef
+	WithShutdownTimeout(timeout time.Duration) ConstructorDef
+	WithHealthCheckTimeout(timeout time.Duration) ConstructorDef
+
+	// WithInitRetry retries resolution of the parameters, the constructor call and initialization according to the policy.
+	WithInitRetry(policy RetryPolicy) ConstructorDef
+}
+
+// PoolDef is the definition of a pooled service, returned by ProvidePool functions.
+type PoolDef interface {
+	ServiceDef
+
+	// NonBlocking makes acquiring an instance fail with [ErrPoolExhausted] when all instances are in use,
+	// by default callers wait until an instance is released or their context is canceled.
+	NonBlocking() PoolDef
+
+	// WithInitTimeout, WithShutdownTimeout and WithHealthCheckTimeout bound the lifecycle phases of every pooled
+	// instance. The timeouts set in [Config] remain the upper bound for the whole phase.
+	WithInitTimeout(timeout time.Duration) PoolDef
+	WithShutdownTimeout(timeout time.Duration) PoolDef
+	WithHealthCheckTimeout(timeout time.Duration) PoolDef
+
+	// WithInitRetry retries creation and initialization of every pooled instance according to the policy.
+	WithInitRetry(policy RetryPolicy) PoolDef
+}
+
+// KeyedDef is the definition of a keyed service, returned by ProvideKeyed functions.
+type KeyedDef interface {
+	ServiceDef
+
+	// WithInitTimeout, WithShutdownTimeout and WithHealthCheckTimeout bound the lifecycle phases of every cached
+	// instance. The timeouts set in [Config] remain the upper bound for the whole phase.
+	WithInitTimeout(timeout time.Duration) KeyedDef
+	WithShutdownTimeout(timeout time.Duration) KeyedDef
+	WithHealthCheckTimeout(timeout time.Duration) KeyedDef
+
+	// WithInitRetry retries creation and initialization of every cached instance according to the policy.
+	WithInitRetry(policy RetryPolicy) KeyedDef
+}
+
 // Optional lifecycle methods a ServiceDef wrapper may implement to drive Init/Run/Shutdown/HealthCheck.
 // Container and RunServices type-assert these; they are not part of [ServiceDef].
 type (
@@ -92,3 +158,15 @@ type Invoker interface {
 	// Only exported fields can be injected into.
 	InjectInto(ctx context.Context, target any) error
 }
+
+// ConfigProvider is implemented by configuration services, for instance by the one from the config package.
+// Fields tagged with `pal:"config=<key>"` are populated by the only registered ConfigProvider, which is
+// initialized before the services having such fields.
+type ConfigProvider interface {
+	// InjectConfig decodes the value found under the key into the field value and validates it.
+	// Returns an error wrapping [ErrConfigKeyNotFound] if there is no value under the key, or wrapping
+	// [ErrConfigInvalid] if the value cannot be decoded or is invalid.
+	InjectConfig(ctx context.Context, key string, field reflect.StructField, value reflect.Value) error
+}
+
+var configProviderType = reflect.TypeOf((*ConfigProvider)(nil)).Elem()
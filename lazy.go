@@ -0,0 +1,96 @@
+package pal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	typetostring "github.com/samber/go-type-to-string"
+)
+
+// Lazy is a dependency that is resolved on first [Lazy.Get] call instead of being injected eagerly.
+// Unlike regular dependencies, a Lazy field does not add an initialization edge to the dependency graph, so
+// the service owning it may be initialized before the dependency. This allows to defer initialization of
+// dependencies used on rare code paths and to break cycles between services referencing each other.
+// If the dependency is not initialized yet when Get is called, it is initialized on demand along with its
+// dependencies that are not initialized yet, dependencies being initialized concurrently are awaited.
+// Lazy respects the `pal:"name=<name>"` tag, the service is looked up by the type name of T otherwise.
+//
+// Calling Get from Init of services forming a cycle observes the services of the cycle that are still being
+// initialized, prefer calling it after Pal is initialized.
+type Lazy[T any] struct {
+	state *lazyState[T]
+}
+
+type lazyState[T any] struct {
+	mu       sync.Mutex
+	name     string
+	resolve  func(ctx context.Context) (any, error)
+	resolved bool
+	value    T
+}
+
+// lazyDependency is implemented by *Lazy[T], it is used by the container to detect and inject lazy fields.
+type lazyDependency interface {
+	lazyDependencyName() string
+	setLazyResolver(name string, resolve func(ctx context.Context) (any, error))
+}
+
+var lazyDependencyType = reflect.TypeOf((*lazyDependency)(nil)).Elem()
+
+// Get resolves the dependency on first call and returns the cached value afterwards.
+// Returns [ErrLazyNotInjected] if the Lazy was not injected by Pal.
+func (l Lazy[T]) Get(ctx context.Context) (T, error) {
+	if l.state == nil {
+		return empty[T](), fmt.Errorf("%w: %s", ErrLazyNotInjected, typetostring.GetType[T]())
+	}
+
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+
+	if l.state.resolved {
+		return l.state.value, nil
+	}
+
+	instance, err := l.state.resolve(ctx)
+	if err != nil {
+		return empty[T](), err
+	}
+
+	value, ok := instance.(T)
+	if !ok {
+		return empty[T](), fmt.Errorf("%w: %s. %+v does not implement %s", ErrServiceInvalid, l.state.name, instance, typetostring.GetType[T]())
+	}
+
+	l.state.value = value
+	l.state.resolved = true
+
+	return value, nil
+}
+
+// MustGet is like Get but panics if an error occurs.
+func (l Lazy[T]) MustGet(ctx context.Context) T {
+	return must(l.Get(ctx))
+}
+
+func (l *Lazy[T]) lazyDependencyName() string {
+	return typetostring.GetType[T]()
+}
+
+func (l *Lazy[T]) setLazyResolver(name string, resolve func(ctx context.Context) (any, error)) {
+	l.state = &lazyState[T]{name: name, resolve: resolve}
+}
+
+// isLazyField reports whether the field type is a [Lazy].
+func isLazyField(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && reflect.PointerTo(t).Implements(lazyDependencyType)
+}
+
+// lazyFieldDependencyName returns the name of the service a Lazy field of the given type resolves to.
+func lazyFieldDependencyName(t reflect.Type, tags map[Tag]string) string {
+	if name := tags[TagName]; name != "" {
+		return name
+	}
+	return reflect.New(t).Interface().(lazyDependency).lazyDependencyName()
+}
@@ -0,0 +1,193 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type lazyCycleA struct {
+	B pal.Lazy[*lazyCycleB]
+}
+
+type lazyCycleB struct {
+	A *lazyCycleA
+}
+
+type lazyLeaf struct {
+	initialized bool
+}
+
+func (l *lazyLeaf) Init(_ context.Context) error {
+	l.initialized = true
+	return nil
+}
+
+type lazyTarget struct {
+	Leaf *lazyLeaf
+
+	leafInitialized bool
+}
+
+func (l *lazyTarget) Init(_ context.Context) error {
+	l.leafInitialized = l.Leaf.initialized
+	return nil
+}
+
+type lazyOwner struct {
+	Target pal.Lazy[*lazyTarget]
+
+	target *lazyTarget
+}
+
+func (l *lazyOwner) Init(ctx context.Context) error {
+	var err error
+	l.target, err = l.Target.Get(ctx)
+	return err
+}
+
+type lazySlowLeaf struct {
+	started     chan struct{}
+	initialized bool
+}
+
+func (l *lazySlowLeaf) Init(_ context.Context) error {
+	close(l.started)
+	time.Sleep(50 * time.Millisecond)
+	l.initialized = true
+	return nil
+}
+
+type lazySlowTarget struct {
+	Leaf *lazySlowLeaf
+
+	leafInitialized bool
+}
+
+func (l *lazySlowTarget) Init(_ context.Context) error {
+	l.leafInitialized = l.Leaf.initialized
+	return nil
+}
+
+type lazyWaitingOwner struct {
+	Target pal.Lazy[*lazySlowTarget]
+
+	leafStarted chan struct{}
+	target      *lazySlowTarget
+}
+
+func (l *lazyWaitingOwner) Init(ctx context.Context) error {
+	<-l.leafStarted
+
+	var err error
+	l.target, err = l.Target.Get(ctx)
+	return err
+}
+
+type lazyNamedOwner struct {
+	Storage pal.Lazy[storageBackend] `pal:"name=tenant-eu-1"`
+}
+
+// TestLazy_Get tests the Get method of Lazy
+func TestLazy_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("resolves the dependency and caches it", func(t *testing.T) {
+		t.Parallel()
+
+		a := &lazyCycleA{}
+		b := &lazyCycleB{}
+
+		p := newPal(pal.Provide(a), pal.Provide(b))
+		require.NoError(t, p.Init(t.Context()))
+
+		resolved, err := a.B.Get(t.Context())
+		require.NoError(t, err)
+
+		assert.Same(t, b, resolved)
+		assert.Same(t, resolved, a.B.MustGet(t.Context()))
+	})
+
+	t.Run("breaks cycles between services", func(t *testing.T) {
+		t.Parallel()
+
+		a := &lazyCycleA{}
+		b := &lazyCycleB{}
+
+		p := newPal(pal.Provide(a), pal.Provide(b))
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Same(t, a, b.A)
+		assert.Same(t, b, a.B.MustGet(t.Context()))
+	})
+
+	t.Run("initializes the dependency and its dependencies on demand", func(t *testing.T) {
+		t.Parallel()
+
+		owner := &lazyOwner{}
+		target := &lazyTarget{}
+		leaf := &lazyLeaf{}
+
+		p := newPal(pal.Provide(owner), pal.Provide(target), pal.Provide(leaf)).InitConcurrency(1)
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Same(t, target, owner.target)
+		assert.True(t, target.leafInitialized)
+		assert.True(t, leaf.initialized)
+	})
+
+	t.Run("waits for dependencies initialized concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		started := make(chan struct{})
+		owner := &lazyWaitingOwner{leafStarted: started}
+		target := &lazySlowTarget{}
+		leaf := &lazySlowLeaf{started: started}
+
+		p := newPal(pal.Provide(owner), pal.Provide(target), pal.Provide(leaf))
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Same(t, target, owner.target)
+		assert.True(t, target.leafInitialized)
+	})
+
+	t.Run("respects name tag", func(t *testing.T) {
+		t.Parallel()
+
+		owner := &lazyNamedOwner{}
+		storage := &tenantStorage{backend: "s3"}
+
+		p := newPal(pal.Provide(owner), pal.ProvideNamed("tenant-eu-1", storage))
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.Same(t, storage, owner.Storage.MustGet(t.Context()))
+	})
+
+	t.Run("returns error when the dependency is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		owner := &lazyNamedOwner{}
+
+		p := newPal(pal.Provide(owner))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := owner.Storage.Get(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+
+	t.Run("returns error when not injected", func(t *testing.T) {
+		t.Parallel()
+
+		var lazy pal.Lazy[*lazyLeaf]
+
+		_, err := lazy.Get(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrLazyNotInjected)
+	})
+}
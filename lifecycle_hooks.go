@@ -1,6 +1,9 @@
 package pal
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // LifecycleHook is a function type that can be registered to run at specific points in a service's lifecycle.
 // It receives the service instance, a context, and an [Invoker] (typically the running [Pal]), and can return an error to indicate failure.
@@ -14,6 +17,14 @@ type lifecycleHooks[T any] struct {
 	HealthCheck LifecycleHook[T]
 }
 
+// lifecycleTimeouts holds per-service timeouts of lifecycle phases. Zero means the service is only bound by
+// the timeout of the phase set in [Config], which remains the upper bound for the whole phase.
+type lifecycleTimeouts struct {
+	Init        time.Duration
+	Shutdown    time.Duration
+	HealthCheck time.Duration
+}
+
 // Hookable is the fluent registration surface returned by [Provide], [ProvideNamed], [ProvideFn], and [ProvideNamedFn].
 // Concrete wrappers such as [ServiceConst] and [ServiceFnSingleton] remain exported for advanced use.
 type Hookable[T any] interface {
@@ -21,4 +32,14 @@ type Hookable[T any] interface {
 	ToInit(hook LifecycleHook[T]) Hookable[T]
 	ToShutdown(hook LifecycleHook[T]) Hookable[T]
 	ToHealthCheck(hook LifecycleHook[T]) Hookable[T]
+
+	// WithInitTimeout, WithShutdownTimeout and WithHealthCheckTimeout bound the lifecycle phases of the service
+	// with its own timeouts. The timeouts set in [Config] remain the upper bound for the whole phase.
+	// With WithInitRetry, the init timeout bounds each attempt separately.
+	WithInitTimeout(timeout time.Duration) Hookable[T]
+	WithShutdownTimeout(timeout time.Duration) Hookable[T]
+	WithHealthCheckTimeout(timeout time.Duration) Hookable[T]
+
+	// WithInitRetry retries creation and initialization of the service according to the policy.
+	WithInitRetry(policy RetryPolicy) Hookable[T]
 }
@@ -13,26 +13,28 @@ type HealthChecker interface {
 	// 1. When Pal.HealthCheck() is called Pal initiates the healthcheck sequence. All services are checked concurrently.
 	// 2. If any service returns an error, Pal initiates a graceful shutdown
 	// 3. Services can use this method to check their internal state or connections to external systems
-	// 4. The context provided has a timeout configured via Pal.HealthCheckTimeout()
+	// 4. The context provided has a timeout configured via Pal.HealthCheckTimeout(), or a shorter one set for the service with WithHealthCheckTimeout
 	HealthCheck(ctx context.Context) error
 }
 
 // Shutdowner is an optional interface that can be implemented by a service.
 type Shutdowner interface {
 	// Shutdown is being called when pal is shutting down the service.
-	// If returns an error, pal will consider this service unhealthy, but will continue to Shutdown the app,
+	// If returns an error, pal will consider this service unhealthy, but will continue to Shutdown the rest of the app,
 	// Pal.Run() will return an error.
 	// ctx has a timeout and only being canceled if it is exceeded.
 	// If all the services shutdown successfully, Pal.Run will return nil.
 	//
 	// The shutdown process works as follows:
 	// 1. Whena termination signal is received or the context passed to Pal.Run() is canceled, Pal initiates the shutdown sequence. Services
-	// 	  are shutdown in dependency order.
+	// 	  are shutdown in dependency order, a service is shut down as soon as all services depending on it are shut down,
+	// 	  independent services are shut down concurrently unless Pal.SequentialShutdown() is used.
 	// 2. Pal cancels the context for all running services (Runners) and awaits for runners to finish.
 	// 3. Pal calls Shutdown() on all services that implement this interface in reverse dependency order
 	// 4. Services should use this method to clean up resources, close connections, etc.
-	// 5. The context provided has a timeout configured via Pal.ShutdownTimeout()
-	// 6. If any service returns an error during shutdown, Pal will collect these errors and return them from Run()
+	// 5. The context provided has a timeout configured via Pal.ShutdownTimeout(), or a shorter one set for the service with WithShutdownTimeout
+	// 6. If any service returns an error during shutdown, Pal still shuts down the remaining services, collects
+	//    the errors as [ServiceError] joined with errors.Join and returns them from Run()
 	Shutdown(ctx context.Context) error
 }
 
@@ -46,7 +48,7 @@ type Initer interface {
 	// 2. Pal initializes services in dependency order.
 	// 3. For each service, Pal injects dependencies and then calls Init() if the service implements this interface
 	// 4. Services should use this method to perform one-time setup operations like connecting to databases
-	// 5. The context provided has a timeout configured via Pal.InitTimeout()
+	// 5. The context provided has a timeout configured via Pal.InitTimeout(), or a shorter one set for the service with WithInitTimeout
 	// 6. If any service returns an error during initialization, Pal will stop the initialization process
 	//    and attempt to gracefully shut down any already initialized services
 	Init(ctx context.Context) error
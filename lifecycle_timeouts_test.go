@@ -0,0 +1,193 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+// slowService blocks in lifecycle methods until the context is done if the matching flag is set,
+// it records the remaining time budget of the last call.
+type slowService struct {
+	slowInit, slowShutdown, slowHealthCheck bool
+
+	budget time.Duration
+}
+
+func (s *slowService) wait(ctx context.Context, slow bool) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		s.budget = time.Until(deadline)
+	}
+	if !slow {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *slowService) Init(ctx context.Context) error {
+	return s.wait(ctx, s.slowInit)
+}
+
+func (s *slowService) Shutdown(ctx context.Context) error {
+	return s.wait(ctx, s.slowShutdown)
+}
+
+func (s *slowService) HealthCheck(ctx context.Context) error {
+	return s.wait(ctx, s.slowHealthCheck)
+}
+
+type slowInstance struct {
+	slowService
+}
+
+func TestLifecycleTimeouts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("init is bound by the service init timeout", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{slowInit: true}
+		p := newPal(pal.Provide(service).WithInitTimeout(10 * time.Millisecond))
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+	})
+
+	t.Run("the phase timeout remains the upper bound", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{slowInit: true}
+		p := newPal(pal.Provide(service).WithInitTimeout(time.Hour)).InitTimeout(10 * time.Millisecond)
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+	})
+
+	t.Run("services without overrides are bound by the phase timeout only", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{}
+		p := newPal(pal.Provide(service), pal.Provide(&Pinger1{}).WithInitTimeout(10*time.Millisecond))
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.Greater(t, service.budget, 10*time.Millisecond)
+	})
+
+	t.Run("shutdown is bound by the service shutdown timeout", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{slowShutdown: true}
+		p := newPal(pal.ProvideFn[*slowService](func(_ context.Context) (*slowService, error) {
+			return service, nil
+		}).WithShutdownTimeout(10 * time.Millisecond))
+		require.NoError(t, p.Init(t.Context()))
+
+		err := p.Container().Shutdown(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+	})
+
+	t.Run("health check is bound by the service health check timeout", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{slowHealthCheck: true}
+		p := newPal(pal.Provide(service).WithHealthCheckTimeout(10 * time.Millisecond))
+		require.NoError(t, p.Init(t.Context()))
+
+		err := p.HealthCheck(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+	})
+
+	t.Run("initialization of factory instances is bound by the factory init timeout", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideFactory1[*slowInstance](func(_ context.Context, slow bool) (*slowInstance, error) {
+			return &slowInstance{slowService{slowInit: slow}}, nil
+		}).WithInitTimeout(10 * time.Millisecond))
+		require.NoError(t, p.Init(t.Context()))
+
+		instance, err := pal.Invoke[*slowInstance](t.Context(), p, false)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, instance.budget, 10*time.Millisecond)
+
+		_, err = pal.Invoke[*slowInstance](t.Context(), p, true)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("creation of factory instances is bound by the factory init timeout", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideFactory0[*slowInstance](func(ctx context.Context) (*slowInstance, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).WithInitTimeout(10 * time.Millisecond))
+		require.NoError(t, p.Init(t.Context()))
+
+		start := time.Now()
+		_, err := pal.Invoke[*slowInstance](t.Context(), p)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("creation of singletons is bound by the service init timeout", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideFn[*slowService](func(ctx context.Context) (*slowService, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).WithInitTimeout(10 * time.Millisecond))
+
+		start := time.Now()
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("constructor calls are bound by the service init timeout", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideConstructor[*slowService](func(ctx context.Context, _ *Pinger1) (*slowService, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).WithInitTimeout(10*time.Millisecond), pal.Provide(&Pinger1{}))
+
+		start := time.Now()
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("constructed services are bound by the service timeouts", func(t *testing.T) {
+		t.Parallel()
+
+		service := &slowService{slowShutdown: true}
+		p := newPal(pal.ProvideConstructor[*slowService](func(_ context.Context) (*slowService, error) {
+			return service, nil
+		}).WithShutdownTimeout(10 * time.Millisecond).WithHealthCheckTimeout(10 * time.Millisecond))
+		require.NoError(t, p.Init(t.Context()))
+
+		require.NoError(t, p.HealthCheck(t.Context()))
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+
+		err := p.Container().Shutdown(t.Context())
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, service.budget, 10*time.Millisecond)
+	})
+}
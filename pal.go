@@ -22,6 +22,11 @@ const (
 	// ctxValue is the key used to store and retrieve the Pal instance from a context.
 	// Use [WithPal] / [FromContext] rather than the key directly.
 	ctxValue contextKey = iota
+	// ctxScope is the key used to store and retrieve the Scope from a context.
+	// Use [WithScope] / [ScopeFromContext] rather than the key directly.
+	ctxScope
+	// ctxInitChain is the key used to store the names of services being initialized by the current call chain.
+	ctxInitChain
 )
 
 // DefaultShutdownSignals is the default signals that will be used to shutdown the app.
@@ -101,6 +106,31 @@ func (p *Pal) ShutdownTimeout(t time.Duration) *Pal {
 	return p
 }
 
+// InitConcurrency limits the number of services initialized concurrently.
+// Services are initialized as soon as all their dependencies are initialized, so independent
+// services are initialized in parallel. 0 (the default) means no limit, 1 initializes services one by one.
+func (p *Pal) InitConcurrency(n int) *Pal {
+	p.config.InitConcurrency = n
+	return p
+}
+
+// SequentialShutdown disables concurrent shutdown.
+// By default, a service is shut down as soon as all services depending on it are shut down, so independent
+// services are shut down in parallel. In sequential mode services are shut down one by one, still in reverse
+// dependency order.
+func (p *Pal) SequentialShutdown() *Pal {
+	p.config.SequentialShutdown = true
+	return p
+}
+
+// Strict enables strict injection: an exported interface or pointer field that cannot be resolved is an error
+// naming the owning service, the field and the expected type, instead of being silently left nil.
+// Fields tagged with `pal:"optional"` and fields already set are allowed to stay unresolved.
+func (p *Pal) Strict() *Pal {
+	p.config.Strict = true
+	return p
+}
+
 // InjectSlog enables automatic slog injection into the services.
 func (p *Pal) InjectSlog(configs ...SlogAttributeSetter) *Pal {
 	if len(configs) == 0 {
@@ -128,16 +158,24 @@ func (p *Pal) RunHealthCheckServer(addr, path string) *Pal {
 }
 
 // HealthCheck verifies the health of the service Container within a configurable timeout.
+// It returns errors of unhealthy services joined, see [Pal.HealthReport].
 func (p *Pal) HealthCheck(ctx context.Context) error {
+	return p.HealthReport(ctx).Err()
+}
+
+// HealthReport health checks all services having a health check within a configurable timeout
+// and reports the status, error, latency and time of the last success of every check.
+func (p *Pal) HealthReport(ctx context.Context) HealthReport {
 	ctx, cancel := context.WithTimeout(ctx, p.config.HealthCheckTimeout)
 	defer cancel()
 
-	return p.container.HealthCheck(ctx)
+	return p.container.HealthReport(ctx)
 }
 
 // Init initializes Pal. Validates config, creates and initializes all singleton services.
-// If any error occurs during initialization, it will return it and
-// will not try to gracefully shutdown already initialized services.
+// If any error occurs during initialization, the services that were already initialized are gracefully
+// shut down in reverse dependency order within the shutdown timeout. The initialization error is returned
+// joined with the errors occurred during the rollback, if any.
 // Only first call is effective.
 func (p *Pal) Init(ctx context.Context) error {
 	if !p.initialized.CompareAndSwap(false, true) {
@@ -154,7 +192,13 @@ func (p *Pal) Init(ctx context.Context) error {
 	defer cancel()
 
 	if err := p.container.Init(initCtx); err != nil {
-		return err
+		p.logger.Error("Init failed, shutting down already initialized services", "error", err)
+
+		// The passed context may already be canceled, but the rollback must still be performed.
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.config.ShutdownTimeout)
+		defer cancel()
+
+		return errors.Join(err, p.container.Shutdown(shutdownCtx))
 	}
 
 	p.logger.Debug("Pal initialized")
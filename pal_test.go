@@ -2,6 +2,10 @@ package pal_test
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"testing"
 	"time"
@@ -13,6 +17,157 @@ import (
 	"github.com/zhulik/pal"
 )
 
+// initBarrier blocks every Init call until all expected services have entered Init.
+type initBarrier struct {
+	wg *sync.WaitGroup
+}
+
+func (b initBarrier) await(ctx context.Context) error {
+	b.wg.Done()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type concurrentIniterA struct{ barrier initBarrier }
+
+func (s *concurrentIniterA) Init(ctx context.Context) error { return s.barrier.await(ctx) }
+
+type concurrentIniterB struct{ barrier initBarrier }
+
+func (s *concurrentIniterB) Init(ctx context.Context) error { return s.barrier.await(ctx) }
+
+type orderedDependency struct{ initialized bool }
+
+func (s *orderedDependency) Init(_ context.Context) error {
+	s.initialized = true
+	return nil
+}
+
+func (s *orderedDependency) isOrderedDependency() {}
+
+type orderedDependencyIface interface {
+	isOrderedDependency()
+}
+
+type orderedInterfaceDependent struct {
+	Dependency orderedDependencyIface `pal:"match_interface"`
+
+	dependencyInitialized bool
+}
+
+func (s *orderedInterfaceDependent) Init(_ context.Context) error {
+	s.dependencyInitialized = s.Dependency.(*orderedDependency).initialized
+	return nil
+}
+
+type orderedDependent struct {
+	Dependency *orderedDependency
+
+	dependencyInitialized bool
+}
+
+func (s *orderedDependent) Init(_ context.Context) error {
+	s.dependencyInitialized = s.Dependency.initialized
+	return nil
+}
+
+type concurrentShutdownerA struct{ barrier initBarrier }
+
+func (s *concurrentShutdownerA) Shutdown(ctx context.Context) error { return s.barrier.await(ctx) }
+
+type concurrentShutdownerB struct{ barrier initBarrier }
+
+func (s *concurrentShutdownerB) Shutdown(ctx context.Context) error { return s.barrier.await(ctx) }
+
+type shutdownDependency struct {
+	shutdown             atomic.Bool
+	dependentWasShutdown bool
+	dependent            *shutdownDependent
+}
+
+func (s *shutdownDependency) Shutdown(_ context.Context) error {
+	s.dependentWasShutdown = s.dependent.shutdown.Load()
+	s.shutdown.Store(true)
+	return nil
+}
+
+type shutdownDependent struct {
+	Dependency *shutdownDependency
+
+	shutdown atomic.Bool
+}
+
+func (s *shutdownDependent) Shutdown(_ context.Context) error {
+	s.shutdown.Store(true)
+	return nil
+}
+
+type rollbackBase struct {
+	shutdownErr error
+	shutdown    bool
+}
+
+func (s *rollbackBase) Shutdown(_ context.Context) error {
+	s.shutdown = true
+	return s.shutdownErr
+}
+
+type rollbackFailing struct {
+	Base *rollbackBase
+
+	shutdown bool
+}
+
+func (s *rollbackFailing) Init(_ context.Context) error {
+	return errTest
+}
+
+func (s *rollbackFailing) Shutdown(_ context.Context) error {
+	s.shutdown = true
+	return nil
+}
+
+type rollbackTop struct {
+	Failing *rollbackFailing
+
+	initialized bool
+	shutdown    bool
+}
+
+func (s *rollbackTop) Init(_ context.Context) error {
+	s.initialized = true
+	return nil
+}
+
+func (s *rollbackTop) Shutdown(_ context.Context) error {
+	s.shutdown = true
+	return nil
+}
+
+type strictConsumer struct {
+	Pinger Pinger
+}
+
+type strictLoggerConsumer struct {
+	Logger *slog.Logger
+}
+
+type strictOptionalConsumer struct {
+	Optional *Pinger1 `pal:"optional"`
+	Preset   *Pinger2
+}
+
 // TestPal_New tests the New function
 func Test_New(t *testing.T) {
 	t.Parallel()
@@ -79,6 +234,72 @@ func Test_FromContext(t *testing.T) {
 	})
 }
 
+// TestPal_Init tests the Init method
+func TestPal_Init(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shuts down only initialized services when init fails", func(t *testing.T) {
+		t.Parallel()
+
+		base := &rollbackBase{}
+		failing := &rollbackFailing{}
+		top := &rollbackTop{}
+
+		err := newPal(
+			pal.Provide(top),
+			pal.Provide(failing),
+			pal.Provide(base),
+		).Init(t.Context())
+
+		require.ErrorIs(t, err, errTest)
+
+		var serviceErr *pal.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		assert.Equal(t, pal.PhaseInit, serviceErr.Phase)
+		assert.Equal(t, "*github.com/zhulik/pal_test.rollbackFailing", serviceErr.Service)
+
+		assert.True(t, base.shutdown)
+		assert.False(t, failing.shutdown)
+		assert.False(t, top.initialized)
+		assert.False(t, top.shutdown)
+	})
+
+	t.Run("returns rollback errors joined with the init error", func(t *testing.T) {
+		t.Parallel()
+
+		base := &rollbackBase{shutdownErr: errTest2}
+
+		err := newPal(
+			pal.Provide(&rollbackFailing{}),
+			pal.Provide(base),
+		).Init(t.Context())
+
+		assert.ErrorIs(t, err, errTest)
+		assert.ErrorIs(t, err, errTest2)
+		assert.True(t, base.shutdown)
+	})
+
+	t.Run("rolls back even if the passed context is canceled", func(t *testing.T) {
+		t.Parallel()
+
+		base := &rollbackBase{}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		p := newPal(
+			pal.Provide(&rollbackFailing{}).ToInit(func(_ context.Context, _ *rollbackFailing, _ pal.Invoker) error {
+				cancel()
+				return errTest
+			}),
+			pal.Provide(base),
+		)
+
+		err := p.Init(ctx)
+
+		assert.ErrorIs(t, err, errTest)
+		assert.True(t, base.shutdown)
+	})
+}
+
 // TestPal_InitTimeout tests the InitTimeout method
 func TestPal_InitTimeout(t *testing.T) {
 	t.Parallel()
@@ -95,6 +316,73 @@ func TestPal_InitTimeout(t *testing.T) {
 	})
 }
 
+// TestPal_InitConcurrency tests the InitConcurrency method
+func TestPal_InitConcurrency(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sets the init concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal()
+
+		result := p.InitConcurrency(2)
+
+		assert.Same(t, p, result) // Method should return the Pal instance for chaining
+		assert.Equal(t, 2, p.Config().InitConcurrency)
+	})
+
+	t.Run("initializes independent services concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		wg := &sync.WaitGroup{}
+		wg.Add(2)
+		barrier := initBarrier{wg: wg}
+
+		p := newPal(
+			pal.Provide(&concurrentIniterA{barrier: barrier}),
+			pal.Provide(&concurrentIniterB{barrier: barrier}),
+		)
+
+		assert.NoError(t, p.Init(t.Context()))
+	})
+
+	t.Run("initializes dependencies before dependents", func(t *testing.T) {
+		t.Parallel()
+
+		dependent := &orderedDependent{}
+
+		p := newPal(
+			pal.Provide(dependent),
+			pal.Provide(&orderedDependency{}),
+		).InitConcurrency(2)
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.True(t, dependent.dependencyInitialized)
+	})
+
+	t.Run("initializes interface matched dependencies before dependents", func(t *testing.T) {
+		t.Parallel()
+
+		dependent := &orderedInterfaceDependent{}
+
+		p := newPal(
+			pal.Provide(dependent),
+			pal.Provide(&orderedDependency{}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.True(t, dependent.dependencyInitialized)
+	})
+
+	t.Run("returns error for negative concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		err := newPal().InitConcurrency(-1).Init(t.Context())
+
+		assert.Error(t, err)
+	})
+}
+
 // TestPal_HealthCheckTimeout tests the HealthCheckTimeout method
 func TestPal_HealthCheckTimeout(t *testing.T) {
 	t.Parallel()
@@ -127,6 +415,147 @@ func TestPal_ShutdownTimeout(t *testing.T) {
 	})
 }
 
+// TestPal_Strict tests the Strict method
+func TestPal_Strict(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enables strict injection", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal()
+
+		result := p.Strict()
+
+		assert.Same(t, p, result) // Method should return the Pal instance for chaining
+		assert.True(t, p.Config().Strict)
+	})
+
+	t.Run("returns error naming the service, the field and the type for unresolved fields", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&strictConsumer{})).Strict()
+
+		err := p.Init(t.Context())
+
+		require.ErrorIs(t, err, pal.ErrServiceNotFound)
+
+		var serviceErr *pal.ServiceError
+		require.ErrorAs(t, err, &serviceErr)
+		assert.Equal(t, "*github.com/zhulik/pal_test.strictConsumer", serviceErr.Service)
+		assert.ErrorContains(t, err, "field 'Pinger'")
+		assert.ErrorContains(t, err, "pal_test.Pinger")
+	})
+
+	t.Run("allows optional and already set fields to stay unresolved", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &strictOptionalConsumer{Preset: &Pinger2{}}
+
+		p := newPal(pal.Provide(consumer)).Strict()
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.Nil(t, consumer.Optional)
+	})
+
+	t.Run("leaves unresolved fields nil when not strict", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &strictConsumer{}
+
+		p := newPal(pal.Provide(consumer))
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.Nil(t, consumer.Pinger)
+	})
+
+	t.Run("unresolved fields are reported by Validate", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&strictConsumer{}), pal.Provide(&strictOptionalConsumer{Preset: &Pinger2{}})).Strict()
+
+		report, err := p.Validate(t.Context())
+
+		require.ErrorIs(t, err, pal.ErrServiceNotFound)
+		require.Len(t, report.Problems, 1)
+		assert.Equal(t, "Pinger", report.Problems[0].Field)
+	})
+
+	t.Run("loggers are resolved like other fields unless slog is injected", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&strictLoggerConsumer{})).Strict()
+
+		report, err := p.Validate(t.Context())
+		require.ErrorIs(t, err, pal.ErrServiceNotFound)
+		require.Len(t, report.Problems, 1)
+		assert.Equal(t, "Logger", report.Problems[0].Field)
+		require.ErrorIs(t, p.Init(t.Context()), pal.ErrServiceNotFound)
+
+		consumer := &strictLoggerConsumer{}
+		p = newPal(pal.Provide(consumer)).Strict().InjectSlog()
+
+		_, err = p.Validate(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, p.Init(t.Context()))
+		assert.NotNil(t, consumer.Logger)
+	})
+}
+
+// TestPal_SequentialShutdown tests the SequentialShutdown method
+func TestPal_SequentialShutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enables sequential shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal()
+
+		result := p.SequentialShutdown()
+
+		assert.Same(t, p, result) // Method should return the Pal instance for chaining
+		assert.True(t, p.Config().SequentialShutdown)
+	})
+
+	t.Run("shuts down independent services concurrently by default", func(t *testing.T) {
+		t.Parallel()
+
+		wg := &sync.WaitGroup{}
+		wg.Add(2)
+		barrier := initBarrier{wg: wg}
+
+		p := newPal(
+			pal.Provide(&concurrentShutdownerA{barrier: barrier}),
+			pal.Provide(&concurrentShutdownerB{barrier: barrier}),
+		)
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.NoError(t, p.Container().Shutdown(t.Context()))
+	})
+
+	for _, sequential := range []bool{false, true} {
+		t.Run(fmt.Sprintf("shuts down dependents before dependencies, sequential: %t", sequential), func(t *testing.T) {
+			t.Parallel()
+
+			dependent := &shutdownDependent{}
+			dependency := &shutdownDependency{dependent: dependent}
+
+			p := newPal(
+				pal.Provide(dependent),
+				pal.Provide(dependency),
+			)
+			if sequential {
+				p = p.SequentialShutdown()
+			}
+			require.NoError(t, p.Init(t.Context()))
+
+			require.NoError(t, p.Container().Shutdown(t.Context()))
+
+			assert.True(t, dependency.shutdown.Load())
+			assert.True(t, dependency.dependentWasShutdown)
+		})
+	}
+}
+
 // TestPal_HealthCheck tests the HealthCheck method
 func TestPal_HealthCheck(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,76 @@
+// Package paltest provides helpers for tests backed by a Pal container.
+package paltest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zhulik/pal"
+)
+
+const (
+	// InitTimeout is the init timeout of Pal instances created by New.
+	InitTimeout = 5 * time.Second
+	// HealthCheckTimeout is the health check timeout of Pal instances created by New.
+	HealthCheckTimeout = 5 * time.Second
+	// ShutdownTimeout is the shutdown timeout of Pal instances created by New.
+	ShutdownTimeout = 5 * time.Second
+)
+
+// override is a service registered with Override, it replaces the service with the same name.
+type override struct {
+	pal.ServiceDef
+}
+
+// New creates a Pal instance with the given services and test timeouts, initializes it and registers its shutdown
+// with t.Cleanup. The test fails with the full error if the initialization or the shutdown fails.
+// Services returned by Override replace the services with the same name, regardless of their position.
+func New(t testing.TB, services ...pal.ServiceDef) *pal.Pal {
+	t.Helper()
+
+	regular := make([]pal.ServiceDef, 0, len(services))
+	overrides := []pal.ServiceDef{}
+
+	for _, service := range services {
+		if o, ok := service.(*override); ok {
+			overrides = append(overrides, o.ServiceDef)
+			continue
+		}
+		regular = append(regular, service)
+	}
+
+	// Services registered later replace services with the same name.
+	p := pal.New(append(regular, overrides...)...).
+		InitTimeout(InitTimeout).
+		HealthCheckTimeout(HealthCheckTimeout).
+		ShutdownTimeout(ShutdownTimeout)
+
+	if err := p.Init(t.Context()); err != nil {
+		t.Fatalf("pal initialization failed: %+v", err)
+	}
+
+	t.Cleanup(func() {
+		// t.Context() is already canceled when cleanup functions are called.
+		ctx, cancel := context.WithTimeout(pal.WithPal(context.Background(), p), ShutdownTimeout)
+		defer cancel()
+
+		if err := p.Container().Shutdown(ctx); err != nil {
+			t.Errorf("pal shutdown failed: %+v", err)
+		}
+	})
+
+	return p
+}
+
+// Override registers impl as I, replacing the service registered as I, for instance with a test double.
+// The rest of the graph is kept, dependents of I receive impl. Overrides must be passed to New directly,
+// not within a [pal.ProvideList].
+func Override[I any](impl I) pal.ServiceDef {
+	return &override{pal.Provide(impl)}
+}
+
+// OverrideNamed is like Override but replaces the service registered with the given name.
+func OverrideNamed[I any](name string, impl I) pal.ServiceDef {
+	return &override{pal.ProvideNamed(name, impl)}
+}
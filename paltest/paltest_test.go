@@ -0,0 +1,176 @@
+package paltest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+	"github.com/zhulik/pal/paltest"
+)
+
+var errTest = errors.New("test error")
+
+type clock interface {
+	Now() string
+}
+
+type realClock struct{}
+
+func (c *realClock) Now() string { return "now" }
+
+type fakeClock struct{}
+
+func (c *fakeClock) Now() string { return "fake" }
+
+type reporter struct {
+	Clock clock
+
+	shutdown bool
+}
+
+func (r *reporter) Shutdown(_ context.Context) error {
+	r.shutdown = true
+	return nil
+}
+
+type failingService struct {
+	initErr     error
+	shutdownErr error
+}
+
+func (s *failingService) Init(_ context.Context) error {
+	return s.initErr
+}
+
+func (s *failingService) Shutdown(_ context.Context) error {
+	return s.shutdownErr
+}
+
+// recordingT records failures instead of failing the test.
+type recordingT struct {
+	testing.TB
+
+	mu       sync.Mutex
+	failures []string
+	cleanups []func()
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Cleanup(fn func()) {
+	r.cleanups = append(r.cleanups, fn)
+}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingT) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// run calls fn in a separate goroutine, so Fatalf can stop it, then runs the registered cleanups.
+func (r *recordingT) run(fn func(t testing.TB)) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(r)
+	}()
+	<-done
+
+	for _, cleanup := range r.cleanups {
+		cleanup()
+	}
+}
+
+// TestNew tests the New function
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("initializes pal and shuts it down on cleanup", func(t *testing.T) {
+		t.Parallel()
+
+		r := &reporter{}
+
+		rt := &recordingT{TB: t}
+		rt.run(func(t testing.TB) {
+			p := paltest.New(t, pal.Provide(r), pal.Provide[clock](&realClock{}))
+
+			assert.Equal(t, "now", pal.MustInvoke[*reporter](t.Context(), p).Clock.Now())
+			assert.False(t, r.shutdown)
+		})
+
+		assert.Empty(t, rt.failures)
+		assert.True(t, r.shutdown)
+	})
+
+	t.Run("fails the test with the full error when init fails", func(t *testing.T) {
+		t.Parallel()
+
+		rt := &recordingT{TB: t}
+		rt.run(func(t testing.TB) {
+			paltest.New(t, pal.Provide(&failingService{initErr: errTest}))
+		})
+
+		require.Len(t, rt.failures, 1)
+		assert.Contains(t, rt.failures[0], errTest.Error())
+	})
+
+	t.Run("fails the test with the full error when shutdown fails", func(t *testing.T) {
+		t.Parallel()
+
+		rt := &recordingT{TB: t}
+		rt.run(func(t testing.TB) {
+			paltest.New(t, pal.Provide(&failingService{shutdownErr: errTest}))
+		})
+
+		require.Len(t, rt.failures, 1)
+		assert.Contains(t, rt.failures[0], errTest.Error())
+	})
+}
+
+// TestOverride tests the Override function
+func TestOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replaces the service regardless of its position", func(t *testing.T) {
+		t.Parallel()
+
+		r := &reporter{}
+
+		paltest.New(t,
+			paltest.Override[clock](&fakeClock{}),
+			pal.Provide(r),
+			pal.Provide[clock](&realClock{}),
+		)
+
+		assert.Equal(t, "fake", r.Clock.Now())
+	})
+}
+
+// TestOverrideNamed tests the OverrideNamed function
+func TestOverrideNamed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replaces the service with the given name", func(t *testing.T) {
+		t.Parallel()
+
+		p := paltest.New(t,
+			pal.ProvideNamed[clock]("clock", &realClock{}),
+			paltest.OverrideNamed[clock]("clock", &fakeClock{}),
+		)
+
+		assert.Equal(t, "fake", pal.MustInvokeNamed[clock](t.Context(), p, "clock").Now())
+	})
+}
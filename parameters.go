@@ -0,0 +1,104 @@
+package pal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// parameterized is implemented by services created by functions with parameters resolved from the container.
+type parameterized interface {
+	parameterTemplates() []parameterTemplate
+}
+
+// parameterTemplate is an empty instance of a struct whose fields are resolved to build function parameters.
+type parameterTemplate struct {
+	instance any
+	// required is true if all fields must be resolved, it is the case for parameters that are not parameter objects.
+	required bool
+}
+
+// parameters resolves parameters of a function from the container.
+// context.Context parameters receive the passed context. Parameters of struct types are parameter objects: their
+// fields are injected like fields of services and respect `pal` tags. Other parameters are resolved by type
+// like untagged fields, and must be resolvable.
+type parameters struct {
+	fnType reflect.Type
+
+	// plain is a struct with a field per parameter resolved by type, fields are named after parameter indexes.
+	plain reflect.Type
+}
+
+func newParameters(fnType reflect.Type) *parameters {
+	fields := []reflect.StructField{}
+
+	for i := 0; i < fnType.NumIn(); i++ {
+		paramType := fnType.In(i)
+		if paramType == contextType || isParameterObject(paramType) {
+			continue
+		}
+
+		fields = append(fields, reflect.StructField{Name: parameterFieldName(i), Type: paramType})
+	}
+
+	return &parameters{fnType: fnType, plain: reflect.StructOf(fields)}
+}
+
+// templates returns empty instances of the parameter objects and of the struct holding the other parameters.
+func (p *parameters) templates() []parameterTemplate {
+	templates := []parameterTemplate{{instance: reflect.New(p.plain).Interface(), required: true}}
+
+	for i := 0; i < p.fnType.NumIn(); i++ {
+		if paramType := p.fnType.In(i); isParameterObject(paramType) {
+			templates = append(templates, parameterTemplate{instance: reflect.New(paramType).Interface()})
+		}
+	}
+
+	return templates
+}
+
+// resolve resolves the parameters using the invoker, returns an error if a parameter cannot be resolved.
+func (p *parameters) resolve(ctx context.Context, invoker Invoker) ([]reflect.Value, error) {
+	plain := reflect.New(p.plain)
+	if err := invoker.InjectInto(ctx, plain.Interface()); err != nil {
+		return nil, err
+	}
+
+	args := make([]reflect.Value, p.fnType.NumIn())
+
+	for i := range args {
+		paramType := p.fnType.In(i)
+
+		switch {
+		case paramType == contextType:
+			args[i] = reflect.ValueOf(&ctx).Elem()
+		case isParameterObject(paramType):
+			object := reflect.New(paramType)
+			if err := invoker.InjectInto(ctx, object.Interface()); err != nil {
+				return nil, fmt.Errorf("parameter %d of %s: %w", i, p.fnType, err)
+			}
+			args[i] = object.Elem()
+		default:
+			args[i] = plain.Elem().FieldByName(parameterFieldName(i))
+			if args[i].IsZero() {
+				return nil, fmt.Errorf("%w: parameter %d of %s expects %s", ErrServiceNotFound, i, p.fnType, paramType)
+			}
+		}
+	}
+
+	return args, nil
+}
+
+// isParameterObject reports whether the parameter is a struct whose fields are injected.
+func isParameterObject(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && !isLazyField(t)
+}
+
+func parameterFieldName(i int) string {
+	return fmt.Sprintf("Param%d", i)
+}
@@ -0,0 +1,96 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy describes how initialization of a service is retried, see WithInitRetry methods of service definitions.
+// Every attempt re-runs the whole pipeline: creation of the instance if it is created by a function, dependency
+// injection and ToInit / PalInit / Init. Retries stop when the init deadline would be exceeded by the next backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first one, values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier is the factor the delay grows by after every attempt, values below 1 default to 2.
+	Multiplier float64
+	// Jitter is the fraction of the delay, between 0 and 1, that is randomly subtracted from it, so instances
+	// starting at the same time do not retry in lockstep.
+	Jitter float64
+	// Retryable reports whether the error is worth retrying, nil means all errors are.
+	Retryable func(err error) bool
+}
+
+// delay returns the delay before the attempt following the given one, attempts are numbered from 1.
+func (p *RetryPolicy) delay(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(p.InitialBackoff)
+	for range attempt - 1 {
+		delay *= multiplier
+		if p.MaxBackoff > 0 && delay >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 {
+		delay = min(delay, float64(p.MaxBackoff))
+	}
+
+	jitter := min(max(p.Jitter, 0), 1)
+	delay -= delay * jitter * rand.Float64() //nolint:gosec
+
+	return time.Duration(delay)
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// retryInit calls init, retrying it according to the policy, if any. Failed attempts are logged with Pal's logger.
+// discard, if not nil, is called before every retry to release what the failed attempt created.
+func retryInit(ctx context.Context, name string, policy *RetryPolicy, p *Pal, init func(ctx context.Context) error, discard func(ctx context.Context)) error {
+	if policy == nil {
+		return init(ctx)
+	}
+
+	logger := p.logger.With("service", name)
+
+	for attempt := 1; ; attempt++ {
+		err := init(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= policy.MaxAttempts || !policy.retryable(err) {
+			return err
+		}
+
+		delay := policy.delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			logger.Error("Init attempt failed, no time left for another attempt", "attempt", attempt, "error", err)
+			return err
+		}
+
+		logger.Warn("Init attempt failed, retrying", "attempt", attempt, "maxAttempts", policy.MaxAttempts, "delay", delay, "error", err)
+
+		if discard != nil {
+			discard(ctx)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		}
+	}
+}
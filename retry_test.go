@@ -0,0 +1,180 @@
+package pal_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+var errPermanent = errors.New("permanent error")
+
+// flakyService fails to initialize until the given number of attempts is made, it counts its shutdowns
+// in the given counter, if any.
+type flakyService struct {
+	failures  int32
+	err       error
+	shutdowns *atomic.Int32
+
+	attempts atomic.Int32
+}
+
+func (s *flakyService) Init(_ context.Context) error {
+	if s.attempts.Add(1) <= s.failures {
+		return s.err
+	}
+	return nil
+}
+
+func (s *flakyService) Shutdown(_ context.Context) error {
+	if s.shutdowns != nil {
+		s.shutdowns.Add(1)
+	}
+	return nil
+}
+
+func retryPolicy(attempts int) pal.RetryPolicy {
+	return pal.RetryPolicy{
+		MaxAttempts:    attempts,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+		Jitter:         0.5,
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries init until it succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		service := &flakyService{failures: 2, err: errTest}
+		p := newPal(pal.Provide(service).WithInitRetry(retryPolicy(3)))
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.EqualValues(t, 3, service.attempts.Load())
+	})
+
+	t.Run("returns the last error when attempts are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		service := &flakyService{failures: 5, err: errTest}
+		p := newPal(pal.Provide(service).WithInitRetry(retryPolicy(3)))
+
+		require.ErrorIs(t, p.Init(t.Context()), errTest)
+		assert.EqualValues(t, 3, service.attempts.Load())
+	})
+
+	t.Run("does not retry errors that are not retryable", func(t *testing.T) {
+		t.Parallel()
+
+		policy := retryPolicy(3)
+		policy.Retryable = func(err error) bool { return !errors.Is(err, errPermanent) }
+
+		service := &flakyService{failures: 5, err: errPermanent}
+		p := newPal(pal.Provide(service).WithInitRetry(policy))
+
+		require.ErrorIs(t, p.Init(t.Context()), errPermanent)
+		assert.EqualValues(t, 1, service.attempts.Load())
+	})
+
+	t.Run("stops retrying when the next attempt would exceed the init deadline", func(t *testing.T) {
+		t.Parallel()
+
+		policy := retryPolicy(3)
+		policy.InitialBackoff = time.Hour
+		policy.MaxBackoff = 0
+
+		service := &flakyService{failures: 5, err: errTest}
+		p := newPal(pal.Provide(service).WithInitRetry(policy))
+
+		require.ErrorIs(t, p.Init(t.Context()), errTest)
+		assert.EqualValues(t, 1, service.attempts.Load())
+	})
+
+	t.Run("re-runs the creation function", func(t *testing.T) {
+		t.Parallel()
+
+		var created atomic.Int32
+		p := newPal(pal.ProvideFn[*flakyService](func(_ context.Context) (*flakyService, error) {
+			if created.Add(1) < 3 {
+				return nil, errTest
+			}
+			return &flakyService{}, nil
+		}).WithInitRetry(retryPolicy(3)))
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.EqualValues(t, 3, created.Load())
+	})
+
+	t.Run("retries creation of factory instances", func(t *testing.T) {
+		t.Parallel()
+
+		var created atomic.Int32
+		p := newPal(pal.ProvideFactory0[*flakyService](func(_ context.Context) (*flakyService, error) {
+			// Only the first instance fails to initialize.
+			return &flakyService{failures: 2 - created.Add(1), err: errTest}, nil
+		}).WithInitRetry(retryPolicy(2)))
+		require.NoError(t, p.Init(t.Context()))
+
+		instance, err := pal.Invoke[*flakyService](t.Context(), p)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 2, created.Load())
+		assert.EqualValues(t, 1, instance.attempts.Load())
+	})
+
+	t.Run("re-runs the constructor", func(t *testing.T) {
+		t.Parallel()
+
+		var created atomic.Int32
+		p := newPal(
+			pal.ProvideConstructor[*flakyService](func(_ context.Context, _ *Pinger1) (*flakyService, error) {
+				if created.Add(1) < 3 {
+					return nil, errTest
+				}
+				return &flakyService{}, nil
+			}).WithInitRetry(retryPolicy(3)),
+			pal.Provide(&Pinger1{}),
+		)
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.EqualValues(t, 3, created.Load())
+	})
+
+	t.Run("shuts down instances created by failed attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var created, shutdowns atomic.Int32
+		p := newPal(pal.ProvideFn[*flakyService](func(_ context.Context) (*flakyService, error) {
+			// Instances created by the first two attempts fail to initialize.
+			return &flakyService{failures: min(3-created.Add(1), 1), err: errTest, shutdowns: &shutdowns}, nil
+		}).WithInitRetry(retryPolicy(3)))
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.EqualValues(t, 3, created.Load())
+		assert.EqualValues(t, 2, shutdowns.Load())
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+		assert.EqualValues(t, 3, shutdowns.Load())
+	})
+
+	t.Run("shuts down constructed instances of failed attempts", func(t *testing.T) {
+		t.Parallel()
+
+		var shutdowns atomic.Int32
+		p := newPal(pal.ProvideConstructor[*flakyService](func(_ context.Context) (*flakyService, error) {
+			return &flakyService{failures: 1, err: errTest, shutdowns: &shutdowns}, nil
+		}).WithInitRetry(retryPolicy(3)))
+
+		require.ErrorIs(t, p.Init(t.Context()), errTest)
+		// The instance of the last attempt is dropped like any instance failing to initialize.
+		assert.EqualValues(t, 2, shutdowns.Load())
+	})
+}
@@ -0,0 +1,166 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// Scope is a child [Invoker] of [Pal] with its own lifetime, typically bound to a request or an operation.
+// Services registered with [ProvideScoped] are created once per scope on first use and shut down
+// in reverse creation order when the scope is closed. All other services are resolved from the parent Pal.
+// Scope is goroutine safe.
+type Scope struct {
+	pal *Pal
+
+	mu      sync.Mutex
+	entries map[string]*scopedEntry
+	created []*scopedEntry // in creation order
+	closed  bool
+}
+
+// scopedEntry holds a scoped instance, done is closed when the instance is created or failed to be created.
+type scopedEntry struct {
+	done chan struct{}
+
+	instance any
+	shutdown func(ctx context.Context) error
+	err      error
+}
+
+// NewScope creates a new scope and returns it along with a copy of ctx that carries both the Pal instance and the
+// scope, so the scope can later be retrieved with [ScopeFromContext].
+// The scope must be closed with [Scope.Close] when it is no longer needed.
+func (p *Pal) NewScope(ctx context.Context) (context.Context, *Scope) {
+	scope := &Scope{
+		pal:     p,
+		entries: map[string]*scopedEntry{},
+	}
+
+	return WithScope(WithPal(ctx, p), scope), scope
+}
+
+// ScopeFromContext retrieves a *Scope from the provided context.
+func ScopeFromContext(ctx context.Context) (*Scope, error) {
+	scope, ok := ctx.Value(ctxScope).(*Scope)
+	if !ok {
+		return nil, ErrScopeIsNotInContext
+	}
+
+	return scope, nil
+}
+
+// MustScopeFromContext is like ScopeFromContext but panics if an error occurs.
+func MustScopeFromContext(ctx context.Context) *Scope {
+	return must(ScopeFromContext(ctx))
+}
+
+// WithScope returns a copy of ctx that carries the given scope.
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, ctxScope, scope)
+}
+
+// Invoke retrieves a service by name. Scoped services are created once per scope,
+// other services are retrieved from the parent Pal.
+// It implements the Invoker interface.
+func (s *Scope) Invoke(ctx context.Context, name string, args ...any) (any, error) {
+	return s.pal.Invoke(WithScope(ctx, s), name, args...)
+}
+
+// InvokeByInterface retrieves a service by interface. Scoped services are created once per scope,
+// other services are retrieved from the parent Pal.
+// It implements the Invoker interface.
+func (s *Scope) InvokeByInterface(ctx context.Context, iface reflect.Type, args ...any) (any, error) {
+	return s.pal.InvokeByInterface(WithScope(ctx, s), iface, args...)
+}
+
+// InjectInto injects services into the fields of the target struct following the same rules as [Pal.InjectInto],
+// scoped dependencies are taken from this scope.
+// It implements the Invoker interface.
+func (s *Scope) InjectInto(ctx context.Context, target any) error {
+	return s.pal.InjectInto(WithScope(ctx, s), target)
+}
+
+// Close shuts down all instances created in this scope in reverse creation order.
+// A failing instance does not stop the process, all errors are collected and returned.
+// Once closed, the scope cannot create new instances. Only first call is effective.
+func (s *Scope) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	created := slices.Clone(s.created)
+	s.mu.Unlock()
+
+	ctx = WithScope(WithPal(ctx, s.pal), s)
+
+	var errs []error
+	for _, entry := range slices.Backward(created) {
+		if err := entry.shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// instance returns the instance stored under the given name, creating it with create on first call.
+func (s *Scope) instance(
+	ctx context.Context,
+	name string,
+	create func(ctx context.Context) (any, func(ctx context.Context) error, error),
+) (any, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, ErrScopeClosed
+	}
+
+	entry, ok := s.entries[name]
+	if ok {
+		s.mu.Unlock()
+
+		select {
+		case <-entry.done:
+			return entry.instance, entry.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	entry = &scopedEntry{done: make(chan struct{})}
+	s.entries[name] = entry
+	s.mu.Unlock()
+
+	instance, shutdown, err := create(ctx)
+
+	s.mu.Lock()
+	closed := err == nil && s.closed
+	switch {
+	case err != nil:
+		// let the next call try again
+		delete(s.entries, name)
+	case !closed:
+		entry.instance, entry.shutdown = instance, shutdown
+		s.created = append(s.created, entry)
+	}
+	s.mu.Unlock()
+
+	if closed {
+		// the scope was closed while the instance was being created, it is not kept
+		err = errors.Join(ErrScopeClosed, shutdown(ctx))
+	}
+
+	entry.err = err
+	close(entry.done)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return instance, nil
+}
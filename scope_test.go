@@ -0,0 +1,240 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type scopedSingleton struct{}
+
+type scopedRequest struct {
+	Singleton *scopedSingleton
+
+	shutdown *[]string
+}
+
+func (s *scopedRequest) Shutdown(_ context.Context) error {
+	*s.shutdown = append(*s.shutdown, "request")
+	return nil
+}
+
+type scopedHandler struct {
+	Request *scopedRequest
+
+	shutdown    *[]string
+	shutdownErr error
+}
+
+func (s *scopedHandler) Shutdown(_ context.Context) error {
+	*s.shutdown = append(*s.shutdown, "handler")
+	return s.shutdownErr
+}
+
+type singletonWithScopedDependency struct {
+	Request *scopedRequest
+}
+
+func newScopedPal(t *testing.T, shutdown *[]string, handlerShutdownErr error) *pal.Pal {
+	t.Helper()
+
+	p := newPal(
+		pal.Provide(&scopedSingleton{}),
+		pal.ProvideScoped[*scopedRequest](func(_ context.Context) (*scopedRequest, error) {
+			return &scopedRequest{shutdown: shutdown}, nil
+		}),
+		pal.ProvideScoped[*scopedHandler](func(_ context.Context) (*scopedHandler, error) {
+			return &scopedHandler{shutdown: shutdown, shutdownErr: handlerShutdownErr}, nil
+		}),
+	)
+	require.NoError(t, p.Init(t.Context()))
+
+	return p
+}
+
+// TestPal_NewScope tests the NewScope method
+func TestPal_NewScope(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns a context carrying pal and the scope", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal()
+
+		ctx, scope := p.NewScope(t.Context())
+
+		assert.Same(t, scope, pal.MustScopeFromContext(ctx))
+		assert.Same(t, p, pal.MustFromContext(ctx))
+	})
+}
+
+// TestScopeFromContext tests the ScopeFromContext function
+func TestScopeFromContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error when scope is not in context", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := pal.ScopeFromContext(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrScopeIsNotInContext)
+	})
+}
+
+// TestScope_Invoke tests the Invoke method of Scope
+func TestScope_Invoke(t *testing.T) {
+	t.Parallel()
+
+	t.Run("creates scoped services once per scope", func(t *testing.T) {
+		t.Parallel()
+
+		p := newScopedPal(t, &[]string{}, nil)
+
+		ctx, scope := p.NewScope(t.Context())
+
+		handler1 := pal.MustInvoke[*scopedHandler](ctx, scope)
+		handler2 := pal.MustInvoke[*scopedHandler](ctx, scope)
+		request := pal.MustInvoke[*scopedRequest](ctx, scope)
+
+		assert.Same(t, handler1, handler2)
+		assert.Same(t, request, handler1.Request)
+		assert.Same(t, pal.MustInvoke[*scopedSingleton](ctx, p), request.Singleton)
+
+		otherCtx, otherScope := p.NewScope(t.Context())
+		assert.NotSame(t, handler1, pal.MustInvoke[*scopedHandler](otherCtx, otherScope))
+	})
+
+	t.Run("resolves scoped services with a nil invoker using the scope from context", func(t *testing.T) {
+		t.Parallel()
+
+		p := newScopedPal(t, &[]string{}, nil)
+
+		ctx, scope := p.NewScope(t.Context())
+
+		assert.Same(t, pal.MustInvoke[*scopedRequest](ctx, scope), pal.MustInvoke[*scopedRequest](ctx, nil))
+	})
+
+	t.Run("returns error when scoped service is invoked outside of a scope", func(t *testing.T) {
+		t.Parallel()
+
+		p := newScopedPal(t, &[]string{}, nil)
+
+		_, err := pal.Invoke[*scopedHandler](t.Context(), p)
+
+		assert.ErrorIs(t, err, pal.ErrScopeIsNotInContext)
+	})
+
+	t.Run("returns error when scope is closed", func(t *testing.T) {
+		t.Parallel()
+
+		p := newScopedPal(t, &[]string{}, nil)
+
+		ctx, scope := p.NewScope(t.Context())
+		require.NoError(t, scope.Close(ctx))
+
+		_, err := pal.Invoke[*scopedHandler](ctx, scope)
+
+		assert.ErrorIs(t, err, pal.ErrScopeClosed)
+	})
+
+	t.Run("singletons cannot depend on scoped services", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&singletonWithScopedDependency{}),
+			pal.ProvideScoped[*scopedRequest](func(_ context.Context) (*scopedRequest, error) {
+				return &scopedRequest{}, nil
+			}),
+		)
+
+		err := p.Init(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrScopeIsNotInContext)
+	})
+}
+
+// TestScope_InjectInto tests the InjectInto method of Scope
+func TestScope_InjectInto(t *testing.T) {
+	t.Parallel()
+
+	t.Run("injects scoped services from the scope", func(t *testing.T) {
+		t.Parallel()
+
+		p := newScopedPal(t, &[]string{}, nil)
+
+		ctx, scope := p.NewScope(t.Context())
+
+		target := &singletonWithScopedDependency{}
+		require.NoError(t, scope.InjectInto(ctx, target))
+
+		assert.Same(t, pal.MustInvoke[*scopedRequest](ctx, scope), target.Request)
+	})
+}
+
+// TestScope_Close tests the Close method of Scope
+func TestScope_Close(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shuts down scoped instances in reverse creation order", func(t *testing.T) {
+		t.Parallel()
+
+		shutdown := []string{}
+		p := newScopedPal(t, &shutdown, nil)
+
+		ctx, scope := p.NewScope(t.Context())
+		pal.MustInvoke[*scopedHandler](ctx, scope)
+
+		require.NoError(t, scope.Close(ctx))
+		require.NoError(t, scope.Close(ctx))
+
+		assert.Equal(t, []string{"handler", "request"}, shutdown)
+	})
+
+	t.Run("continues after a failing instance and returns the error", func(t *testing.T) {
+		t.Parallel()
+
+		shutdown := []string{}
+		p := newScopedPal(t, &shutdown, errTest)
+
+		ctx, scope := p.NewScope(t.Context())
+		pal.MustInvoke[*scopedHandler](ctx, scope)
+
+		err := scope.Close(ctx)
+
+		assert.ErrorIs(t, err, errTest)
+		assert.Equal(t, []string{"handler", "request"}, shutdown)
+	})
+
+	t.Run("shuts down instances created while the scope is closed", func(t *testing.T) {
+		t.Parallel()
+
+		shutdown := []string{}
+		creating := make(chan struct{})
+		proceed := make(chan struct{})
+		p := newPal(pal.ProvideScoped[*scopedRequest](func(_ context.Context) (*scopedRequest, error) {
+			close(creating)
+			<-proceed
+			return &scopedRequest{shutdown: &shutdown}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		ctx, scope := p.NewScope(t.Context())
+
+		errs := make(chan error)
+		go func() {
+			_, err := pal.Invoke[*scopedRequest](ctx, scope)
+			errs <- err
+		}()
+
+		<-creating
+		require.NoError(t, scope.Close(ctx))
+		close(proceed)
+
+		require.ErrorIs(t, <-errs, pal.ErrScopeClosed)
+		assert.Equal(t, []string{"request"}, shutdown)
+	})
+}
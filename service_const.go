@@ -2,6 +2,7 @@ package pal
 
 import (
 	"context"
+	"time"
 )
 
 // ServiceConst is a service that wraps a constant value.
@@ -34,7 +35,9 @@ func (c *ServiceConst[T]) Run(ctx context.Context) error {
 // Init injects dependencies into the stored instance, then runs ToInit / PalInit / Init.
 // Same post-create pipeline as [ServiceFnSingleton.Init].
 func (c *ServiceConst[T]) Init(ctx context.Context) error {
-	return initService(ctx, c.Name(), c.instance, c.hooks.Init, c.P)
+	return retryInit(ctx, c.Name(), c.initRetry, c.P, func(ctx context.Context) error {
+		return initService(ctx, c.Name(), c.instance, c.hooks.Init, c.timeouts.Init, c.P)
+	}, nil)
 }
 
 // Make is a no-op for factory services as they are created on demand.
@@ -44,12 +47,16 @@ func (c *ServiceConst[T]) Make() any {
 
 // HealthCheck performs a health check on the service if it implements the HealthChecker interface.
 func (c *ServiceConst[T]) HealthCheck(ctx context.Context) error {
-	return healthcheckService(ctx, c.Name(), c.instance, c.hooks.HealthCheck, c.P)
+	return healthcheckService(ctx, c.Name(), c.instance, c.hooks.HealthCheck, c.timeouts.HealthCheck, c.P)
+}
+
+func (c *ServiceConst[T]) healthCheckable() bool {
+	return hasHealthCheck(c.instance, c.hooks.HealthCheck)
 }
 
 // Shutdown gracefully shuts down the service if it implements the Shutdowner interface.
 func (c *ServiceConst[T]) Shutdown(ctx context.Context) error {
-	return shutdownService(ctx, c.Name(), c.instance, c.hooks.Shutdown, c.P)
+	return shutdownService(ctx, c.Name(), c.instance, c.hooks.Shutdown, c.timeouts.Shutdown, c.P)
 }
 
 // Instance returns the constant instance of the service.
@@ -79,3 +86,27 @@ func (c *ServiceConst[T]) ToHealthCheck(hook LifecycleHook[T]) Hookable[T] {
 	c.hooks.HealthCheck = hook
 	return c
 }
+
+// WithInitTimeout sets the timeout of the service initialization, see [Config.InitTimeout].
+func (c *ServiceConst[T]) WithInitTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of the service shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceConst[T]) WithShutdownTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of the service health check, see [Config.HealthCheckTimeout].
+func (c *ServiceConst[T]) WithHealthCheckTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of the service according to the policy, see [RetryPolicy].
+func (c *ServiceConst[T]) WithInitRetry(policy RetryPolicy) Hookable[T] {
+	c.initRetry = &policy
+	return c
+}
@@ -0,0 +1,126 @@
+package pal
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// ServiceConstructor is a singleton service created by a constructor function with parameters resolved from the container.
+// It is created during initialization, after the services its parameters resolve to are initialized.
+//
+// Advanced: prefer [ProvideConstructor] for normal registration; this type remains exported for power users.
+type ServiceConstructor[I any] struct {
+	ServiceTyped[I]
+	fn     reflect.Value
+	params *parameters
+
+	instance any
+}
+
+func (c *ServiceConstructor[I]) ShouldWaitForRunner() *bool {
+	if wait, ok := palOrStandardShouldWaitForRunner(c.instance); ok {
+		return new(wait)
+	}
+	if instanceImplementsRunner(c.instance) || instanceImplementsRunner(c.Make()) {
+		return new(true)
+	}
+	return nil
+}
+
+// Run executes the service if it implements the Runner interface.
+func (c *ServiceConstructor[I]) Run(ctx context.Context) error {
+	return runService(ctx, c.Name(), c.instance, c.P)
+}
+
+// Init resolves the parameters and calls the constructor, then runs the same pipeline as
+// [ServiceFnSingleton.Init]: inject dependencies, then PalInit / Init.
+// Every attempt of the whole pipeline is bound by the init timeout of the service and is retried according
+// to its init retry policy. Instances created by failed attempts are shut down before the next attempt.
+func (c *ServiceConstructor[I]) Init(ctx context.Context) error {
+	var failed any
+	return retryInit(ctx, c.Name(), c.initRetry, c.P, func(ctx context.Context) error {
+		ctx, cancel := withTimeout(withInitChain(ctx, c.Name()), c.timeouts.Init)
+		defer cancel()
+
+		args, err := c.params.resolve(ctx, c.P)
+		if err != nil {
+			return err
+		}
+
+		results := c.fn.Call(args)
+		if err, _ := results[1].Interface().(error); err != nil {
+			return err
+		}
+
+		instance := results[0].Interface()
+
+		if err := initService(ctx, c.Name(), instance, nil, 0, c.P); err != nil {
+			failed = instance
+			return err
+		}
+
+		c.instance = instance
+		return nil
+	}, func(ctx context.Context) {
+		if failed == nil {
+			return
+		}
+		// Shutdown errors are logged, the next attempt is made regardless.
+		_ = shutdownService(ctx, c.Name(), failed, nil, c.timeouts.Shutdown, c.P)
+		failed = nil
+	})
+}
+
+// Make returns an empty instance of the type returned by the constructor.
+func (c *ServiceConstructor[I]) Make() any {
+	return reflect.New(c.fn.Type().Out(0).Elem()).Interface()
+}
+
+// HealthCheck performs a health check on the service if it implements the HealthChecker interface.
+func (c *ServiceConstructor[I]) HealthCheck(ctx context.Context) error {
+	return healthcheckService(ctx, c.Name(), c.instance, nil, c.timeouts.HealthCheck, c.P)
+}
+
+func (c *ServiceConstructor[I]) healthCheckable() bool {
+	return hasHealthCheck(c.instance, nil)
+}
+
+// Shutdown gracefully shuts down the service if it implements the Shutdowner interface.
+func (c *ServiceConstructor[I]) Shutdown(ctx context.Context) error {
+	return shutdownService(ctx, c.Name(), c.instance, nil, c.timeouts.Shutdown, c.P)
+}
+
+// Instance returns the singleton instance of the service.
+func (c *ServiceConstructor[I]) Instance(_ context.Context, _ ...any) (any, error) {
+	return c.instance, nil
+}
+
+func (c *ServiceConstructor[I]) parameterTemplates() []parameterTemplate {
+	return c.params.templates()
+}
+
+// WithInitTimeout sets the timeout of the service initialization, see [Config.InitTimeout].
+func (c *ServiceConstructor[I]) WithInitTimeout(timeout time.Duration) ConstructorDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of the service shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceConstructor[I]) WithShutdownTimeout(timeout time.Duration) ConstructorDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of the service health check, see [Config.HealthCheckTimeout].
+func (c *ServiceConstructor[I]) WithHealthCheckTimeout(timeout time.Duration) ConstructorDef {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries resolution of the parameters, the constructor call and initialization according
+// to the policy, see [RetryPolicy].
+func (c *ServiceConstructor[I]) WithInitRetry(policy RetryPolicy) ConstructorDef {
+	c.initRetry = &policy
+	return c
+}
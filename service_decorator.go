@@ -0,0 +1,79 @@
+package pal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// decorator is implemented by services registered with Decorate, it is used by the container to
+// chain decorators of the same service and to route dependents to the outermost one.
+type decorator interface {
+	decoratedName() string
+	setDecorated(inner ServiceDef)
+}
+
+// ServiceDecorator is a service that wraps the instance of another singleton service.
+// It is initialized after the service it wraps and the wrapped instance is returned to the dependents instead.
+//
+// Advanced: prefer [Decorate] for normal registration; this type remains exported for power users.
+type ServiceDecorator[I, T any] struct {
+	ServiceTyped[T]
+	target string
+	fn     func(ctx context.Context, inner I) (T, error)
+
+	// inner is the decorated service: the original service or the previous decorator
+	inner    ServiceDef
+	instance T
+}
+
+// Init wraps the instance of the inner service with the decorator function and injects dependencies into the result.
+// Fields resolving to the decorated service are not injected, they keep the value set by the decorator function.
+// Lifecycle methods of the result are not called, the decorated service keeps its own lifecycle.
+func (c *ServiceDecorator[I, T]) Init(ctx context.Context) error {
+	innerInstance, err := c.inner.Instance(ctx)
+	if err != nil {
+		return err
+	}
+
+	inner, ok := innerInstance.(I)
+	if !ok {
+		return fmt.Errorf("%w: '%s' is %T, cannot be decorated as %T", ErrServiceInvalid, c.target, innerInstance, empty[I]())
+	}
+
+	instance, err := c.fn(ctx, inner)
+	if err != nil {
+		return err
+	}
+
+	if !isNil(instance) && reflect.TypeOf(instance).Kind() == reflect.Pointer && reflect.TypeOf(instance).Elem().Kind() == reflect.Struct {
+		if err := c.P.container.injectInto(WithPal(ctx, c.P), instance, c.target); err != nil {
+			return err
+		}
+	}
+
+	c.instance = instance
+	return nil
+}
+
+// Make returns an empty instance of T if it is a pointer, so the decorator's own dependencies are added to the graph.
+func (c *ServiceDecorator[I, T]) Make() any {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Pointer {
+		return nil
+	}
+	return reflect.New(typ.Elem()).Interface()
+}
+
+// Instance returns the decorated instance.
+func (c *ServiceDecorator[I, T]) Instance(_ context.Context, _ ...any) (any, error) {
+	return c.instance, nil
+}
+
+func (c *ServiceDecorator[I, T]) decoratedName() string {
+	return c.target
+}
+
+func (c *ServiceDecorator[I, T]) setDecorated(inner ServiceDef) {
+	c.inner = inner
+}
@@ -1,12 +1,32 @@
 package pal
 
-import "reflect"
+import (
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"sync"
+)
 
 // ServiceFactory is the shared base for arity-specific factory wrappers.
 //
 // Advanced: prefer [ProvideFactory0]–[ProvideFactory5]; this type remains exported for power users.
 type ServiceFactory[I any, T any] struct {
 	ServiceTyped[I]
+
+	// tracked holds instances created by a tracked factory, it is nil unless the factory is tracked.
+	tracked *trackedInstances
+}
+
+// trackedInstances holds instances created by a tracked factory in creation order.
+type trackedInstances struct {
+	mu        sync.Mutex
+	instances []any
+}
+
+// instanceReleaser is implemented by factory services.
+type instanceReleaser interface {
+	release(ctx context.Context, instance any) (bool, error)
 }
 
 // Make is a no-op for factory services as they are created on demand.
@@ -15,3 +35,96 @@ func (c *ServiceFactory[I, T]) Make() any {
 	typ := reflect.TypeOf(t).Elem()
 	return reflect.New(typ).Interface().(I)
 }
+
+// Shutdown shuts down instances created by a tracked factory that were not released, in reverse creation order.
+// All instances are shut down even if some of them fail, the errors are joined.
+func (c *ServiceFactory[I, T]) Shutdown(ctx context.Context) error {
+	if c.tracked == nil {
+		return nil
+	}
+
+	c.tracked.mu.Lock()
+	instances := c.tracked.instances
+	c.tracked.instances = nil
+	c.tracked.mu.Unlock()
+
+	errs := []error{}
+	for _, instance := range slices.Backward(instances) {
+		if err := shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// build creates an instance with the function and initializes it, every attempt of the whole pipeline is bound by
+// the init timeout of the service and is retried according to its init retry policy. Instances created by failed
+// attempts are shut down before the next attempt.
+func (c *ServiceFactory[I, T]) build(ctx context.Context, fn func(ctx context.Context) (T, error), hooks lifecycleHooks[T]) (T, error) {
+	var instance T
+	var failed *T
+	err := retryInit(ctx, c.Name(), c.initRetry, c.P, func(ctx context.Context) error {
+		ctx, cancel := withTimeout(withInitChain(ctx, c.Name()), c.timeouts.Init)
+		defer cancel()
+
+		created, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := initService(ctx, c.Name(), created, hooks.Init, 0, c.P); err != nil {
+			failed = &created
+			return err
+		}
+
+		instance = created
+		return nil
+	}, func(ctx context.Context) {
+		if failed == nil {
+			return
+		}
+		// Shutdown errors are logged, the next attempt is made regardless.
+		_ = shutdownService(ctx, c.Name(), *failed, hooks.Shutdown, c.timeouts.Shutdown, c.P)
+		failed = nil
+	})
+	if err != nil {
+		return empty[T](), err
+	}
+
+	return instance, nil
+}
+
+func (c *ServiceFactory[I, T]) track() {
+	c.tracked = &trackedInstances{}
+}
+
+// remember records the instance if the factory is tracked.
+func (c *ServiceFactory[I, T]) remember(instance any) {
+	if c.tracked == nil {
+		return
+	}
+
+	c.tracked.mu.Lock()
+	defer c.tracked.mu.Unlock()
+
+	c.tracked.instances = append(c.tracked.instances, instance)
+}
+
+// release shuts down the instance and stops tracking it, released is false if the instance is not tracked by the factory.
+func (c *ServiceFactory[I, T]) release(ctx context.Context, instance any) (bool, error) {
+	if c.tracked == nil {
+		return false, nil
+	}
+
+	c.tracked.mu.Lock()
+	index := slices.IndexFunc(c.tracked.instances, func(tracked any) bool { return tracked == instance })
+	if index < 0 {
+		c.tracked.mu.Unlock()
+		return false, nil
+	}
+	c.tracked.instances = slices.Delete(c.tracked.instances, index, index+1)
+	c.tracked.mu.Unlock()
+
+	return true, shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P)
+}
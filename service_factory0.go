@@ -2,6 +2,7 @@ package pal
 
 import (
 	"context"
+	"time"
 )
 
 // ServiceFactory0 is a factory service that creates a new instance each time it is invoked.
@@ -13,15 +14,14 @@ type ServiceFactory0[I any, T any] struct {
 
 // Instance creates and returns a new instance of the service using the provided function.
 func (c *ServiceFactory0[I, T]) Instance(ctx context.Context, _ ...any) (any, error) {
-	instance, err := c.fn(ctx)
+	instance, err := c.build(ctx, func(ctx context.Context) (T, error) {
+		return c.fn(ctx)
+	}, lifecycleHooks[T]{})
 	if err != nil {
 		return nil, err
 	}
 
-	err = initService(ctx, c.Name(), instance, nil, c.P)
-	if err != nil {
-		return nil, err
-	}
+	c.remember(instance)
 
 	return instance, nil
 }
@@ -47,3 +47,27 @@ func (c *ServiceFactory0[I, T]) MustFactory() any {
 		return must(c.Instance(ctx)).(I)
 	}
 }
+
+// Tracked makes Pal shut down instances created by the factory, see [FactoryDef].
+func (c *ServiceFactory0[I, T]) Tracked() FactoryDef {
+	c.track()
+	return c
+}
+
+// WithInitTimeout sets the timeout of created instances initialization, see [Config.InitTimeout].
+func (c *ServiceFactory0[I, T]) WithInitTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of created instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFactory0[I, T]) WithShutdownTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of created instances according to the policy, see [RetryPolicy].
+func (c *ServiceFactory0[I, T]) WithInitRetry(policy RetryPolicy) FactoryDef {
+	c.initRetry = &policy
+	return c
+}
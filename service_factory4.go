@@ -3,6 +3,7 @@ package pal
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // ServiceFactory4 is a factory service that creates a new instance each time it is invoked.
@@ -38,15 +39,14 @@ func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) Instance(ctx context.Context, ar
 		return nil, fmt.Errorf("%w: %T, expected %T", ErrServiceInvalidArgumentType, args[3], p4)
 	}
 
-	instance, err := c.fn(ctx, p1, p2, p3, p4)
+	instance, err := c.build(ctx, func(ctx context.Context) (T, error) {
+		return c.fn(ctx, p1, p2, p3, p4)
+	}, lifecycleHooks[T]{})
 	if err != nil {
 		return nil, err
 	}
 
-	err = initService(ctx, c.Name(), instance, nil, c.P)
-	if err != nil {
-		return nil, err
-	}
+	c.remember(instance)
 
 	return instance, nil
 }
@@ -72,3 +72,27 @@ func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) MustFactory() any {
 		return must(c.Instance(ctx, p1, p2, p3, p4)).(I)
 	}
 }
+
+// Tracked makes Pal shut down instances created by the factory, see [FactoryDef].
+func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) Tracked() FactoryDef {
+	c.track()
+	return c
+}
+
+// WithInitTimeout sets the timeout of created instances initialization, see [Config.InitTimeout].
+func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) WithInitTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of created instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) WithShutdownTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of created instances according to the policy, see [RetryPolicy].
+func (c *ServiceFactory4[I, T, P1, P2, P3, P4]) WithInitRetry(policy RetryPolicy) FactoryDef {
+	c.initRetry = &policy
+	return c
+}
@@ -3,6 +3,7 @@ package pal
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 // ServiceFactory5 is a factory service that creates a new instance each time it is invoked.
@@ -43,15 +44,14 @@ func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) Instance(ctx context.Context
 		return nil, fmt.Errorf("%w: %T, expected %T", ErrServiceInvalidArgumentType, args[4], p5)
 	}
 
-	instance, err := c.fn(ctx, p1, p2, p3, p4, p5)
+	instance, err := c.build(ctx, func(ctx context.Context) (T, error) {
+		return c.fn(ctx, p1, p2, p3, p4, p5)
+	}, lifecycleHooks[T]{})
 	if err != nil {
 		return nil, err
 	}
 
-	err = initService(ctx, c.Name(), instance, nil, c.P)
-	if err != nil {
-		return nil, err
-	}
+	c.remember(instance)
 
 	return instance, nil
 }
@@ -77,3 +77,27 @@ func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) MustFactory() any {
 		return must(c.Instance(ctx, p1, p2, p3, p4, p5)).(I)
 	}
 }
+
+// Tracked makes Pal shut down instances created by the factory, see [FactoryDef].
+func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) Tracked() FactoryDef {
+	c.track()
+	return c
+}
+
+// WithInitTimeout sets the timeout of created instances initialization, see [Config.InitTimeout].
+func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) WithInitTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of created instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) WithShutdownTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of created instances according to the policy, see [RetryPolicy].
+func (c *ServiceFactory5[I, T, P1, P2, P3, P4, P5]) WithInitRetry(policy RetryPolicy) FactoryDef {
+	c.initRetry = &policy
+	return c
+}
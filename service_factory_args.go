@@ -0,0 +1,84 @@
+package pal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ServiceFactoryArgs is a factory service that creates a new instance each time it is invoked.
+// It uses the provided function with a struct of arguments to create the instance.
+type ServiceFactoryArgs[I any, T any, A any] struct {
+	ServiceFactory[I, T]
+	fn func(ctx context.Context, args A) (T, error)
+}
+
+// Arguments returns 1, the struct of arguments is passed as a single argument.
+func (c *ServiceFactoryArgs[I, T, A]) Arguments() int {
+	return 1
+}
+
+// Instance creates and returns a new instance of the service using the provided function.
+func (c *ServiceFactoryArgs[I, T, A]) Instance(ctx context.Context, args ...any) (any, error) {
+	a, ok := args[0].(A)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T, expected %T", ErrServiceInvalidArgumentType, args[0], a)
+	}
+
+	instance, err := c.build(ctx, func(ctx context.Context) (T, error) {
+		return c.fn(ctx, a)
+	}, lifecycleHooks[T]{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.remember(instance)
+
+	return instance, nil
+}
+
+// Factory returns a function that creates a new instance of the service.
+// The returned function has the signature func(ctx context.Context, args A) (I, error).
+func (c *ServiceFactoryArgs[I, T, A]) Factory() any {
+	return func(ctx context.Context, args A) (I, error) {
+		instance, err := c.Instance(ctx, args)
+		if err != nil {
+			var i I
+			return i, err
+		}
+		return instance.(I), nil
+	}
+}
+
+// MustFactory returns a function that creates a new instance of the service.
+// The returned function has the signature func(ctx context.Context, args A) I.
+// If the instance creation fails, it panics.
+func (c *ServiceFactoryArgs[I, T, A]) MustFactory() any {
+	return func(ctx context.Context, args A) I {
+		return must(c.Instance(ctx, args)).(I)
+	}
+}
+
+// Tracked makes Pal shut down instances created by the factory, see [FactoryDef].
+func (c *ServiceFactoryArgs[I, T, A]) Tracked() FactoryDef {
+	c.track()
+	return c
+}
+
+// WithInitTimeout sets the timeout of created instances initialization, see [Config.InitTimeout].
+func (c *ServiceFactoryArgs[I, T, A]) WithInitTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of created instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFactoryArgs[I, T, A]) WithShutdownTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of created instances according to the policy, see [RetryPolicy].
+func (c *ServiceFactoryArgs[I, T, A]) WithInitRetry(policy RetryPolicy) FactoryDef {
+	c.initRetry = &policy
+	return c
+}
@@ -0,0 +1,129 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type connectionArgs struct {
+	URL     string
+	Timeout time.Duration
+}
+
+type connection struct {
+	args connectionArgs
+}
+
+type serviceWithFactoryArgsDependency struct {
+	Connect     func(ctx context.Context, args connectionArgs) (*connection, error)
+	MustConnect func(ctx context.Context, args connectionArgs) *connection
+}
+
+func provideConnectionFactory() pal.ServiceDef {
+	return pal.ProvideFactoryArgs[*connection](func(_ context.Context, args connectionArgs) (*connection, error) {
+		if args.URL == "" {
+			return nil, errTest
+		}
+		return &connection{args: args}, nil
+	})
+}
+
+func TestServiceFactoryArgs_Invocation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when invoked with InvokeWith, returns a new instance built with given arguments", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideConnectionFactory())
+		require.NoError(t, p.Init(t.Context()))
+
+		args := connectionArgs{URL: "postgres://localhost", Timeout: time.Second}
+
+		instance1, err := pal.InvokeWith[*connection](t.Context(), p, args)
+		require.NoError(t, err)
+		assert.Equal(t, args, instance1.args)
+
+		instance2 := pal.MustInvokeWith[*connection](t.Context(), p, args)
+		assert.NotSame(t, instance1, instance2)
+	})
+
+	t.Run("when invoked with incorrect argument type, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideConnectionFactory())
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.InvokeWith[*connection](t.Context(), p, factory1Service{})
+
+		assert.ErrorIs(t, err, pal.ErrServiceInvalidArgumentType)
+	})
+
+	t.Run("when invoked without arguments, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideConnectionFactory())
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.Invoke[*connection](t.Context(), p)
+
+		assert.ErrorIs(t, err, pal.ErrServiceInvalidArgumentsCount)
+	})
+
+	t.Run("when the function fails, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideConnectionFactory())
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.InvokeWith[*connection](t.Context(), p, connectionArgs{})
+
+		assert.ErrorIs(t, err, pal.ErrServiceInitFailed)
+		assert.ErrorIs(t, err, errTest)
+	})
+
+	t.Run("when invoked via injected factory functions, returns new instances built with given arguments", func(t *testing.T) {
+		t.Parallel()
+
+		service := &serviceWithFactoryArgsDependency{}
+		p := newPal(provideConnectionFactory(), pal.Provide(service))
+		require.NoError(t, p.Init(t.Context()))
+
+		conn, err := service.Connect(t.Context(), connectionArgs{URL: "a"})
+		require.NoError(t, err)
+		assert.Equal(t, "a", conn.args.URL)
+
+		assert.Equal(t, "b", service.MustConnect(t.Context(), connectionArgs{URL: "b"}).args.URL)
+
+		_, err = service.Connect(t.Context(), connectionArgs{})
+		assert.ErrorIs(t, err, errTest)
+	})
+
+	t.Run("when registered with a name, is invoked by name", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideNamedFactoryArgs[*connection]("conn", func(_ context.Context, args connectionArgs) (*connection, error) {
+			return &connection{args: args}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		conn, err := pal.InvokeNamedWith[*connection](t.Context(), p, "conn", connectionArgs{URL: "named"})
+		require.NoError(t, err)
+		assert.Equal(t, "named", conn.args.URL)
+	})
+
+	t.Run("panics when arguments are not a struct", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() {
+			pal.ProvideFactoryArgs[*connection](func(_ context.Context, url string) (*connection, error) {
+				return &connection{args: connectionArgs{URL: url}}, nil
+			})
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package pal
+
+import (
+	"context"
+	"time"
+)
+
+// ServiceFactoryAssisted is a factory service that creates a new instance each time it is invoked.
+// It uses the provided function with an argument supplied by the caller and a struct of dependencies
+// resolved from the container. Dependencies are initialized before the factory can be invoked.
+type ServiceFactoryAssisted[I any, T any, A any, D any] struct {
+	ServiceFactoryArgs[I, T, A]
+}
+
+func (c *ServiceFactoryAssisted[I, T, A, D]) parameterTemplates() []parameterTemplate {
+	return []parameterTemplate{{instance: new(D)}}
+}
+
+func newServiceFactoryAssisted[I any, T any, A any, D any](name string, fn func(ctx context.Context, args A, deps D) (T, error)) *ServiceFactoryAssisted[I, T, A, D] {
+	service := &ServiceFactoryAssisted[I, T, A, D]{}
+
+	service.ServiceFactoryArgs = ServiceFactoryArgs[I, T, A]{
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: name}},
+		fn: func(ctx context.Context, args A) (T, error) {
+			var deps D
+			if err := service.P.InjectInto(ctx, &deps); err != nil {
+				return empty[T](), err
+			}
+			return fn(ctx, args, deps)
+		},
+	}
+
+	return service
+}
+
+// Tracked makes Pal shut down instances created by the factory, see [FactoryDef].
+func (c *ServiceFactoryAssisted[I, T, A, D]) Tracked() FactoryDef {
+	c.track()
+	return c
+}
+
+// WithInitTimeout sets the timeout of created instances initialization, see [Config.InitTimeout].
+func (c *ServiceFactoryAssisted[I, T, A, D]) WithInitTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of created instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFactoryAssisted[I, T, A, D]) WithShutdownTimeout(timeout time.Duration) FactoryDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of created instances according to the policy, see [RetryPolicy].
+func (c *ServiceFactoryAssisted[I, T, A, D]) WithInitRetry(policy RetryPolicy) FactoryDef {
+	c.initRetry = &policy
+	return c
+}
@@ -0,0 +1,114 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type sharedTransport struct {
+	initialized bool
+}
+
+func (t *sharedTransport) Init(_ context.Context) error {
+	t.initialized = true
+	return nil
+}
+
+type assistedClient struct {
+	URL       string
+	Transport *sharedTransport
+}
+
+type assistedClientDeps struct {
+	Transport *sharedTransport
+}
+
+type serviceWithAssistedFactoryDependency struct {
+	CreateClient func(ctx context.Context, url string) (*assistedClient, error)
+}
+
+func provideAssistedClientFactory() pal.ServiceDef {
+	return pal.ProvideAssistedFactory[*assistedClient](func(_ context.Context, url string, deps assistedClientDeps) (*assistedClient, error) {
+		return &assistedClient{URL: url, Transport: deps.Transport}, nil
+	})
+}
+
+func TestServiceFactoryAssisted_Invocation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when invoked via injected factory function, mixes caller arguments with resolved dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		transport := &sharedTransport{}
+		consumer := &serviceWithAssistedFactoryDependency{}
+		p := newPal(provideAssistedClientFactory(), pal.Provide(transport), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		client, err := consumer.CreateClient(t.Context(), "https://example.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://example.com", client.URL)
+		assert.Same(t, transport, client.Transport)
+		assert.True(t, client.Transport.initialized)
+	})
+
+	t.Run("when invoked with InvokeWith, mixes caller arguments with resolved dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		transport := &sharedTransport{}
+		p := newPal(provideAssistedClientFactory(), pal.Provide(transport))
+		require.NoError(t, p.Init(t.Context()))
+
+		client, err := pal.InvokeWith[*assistedClient](t.Context(), p, "https://example.com")
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://example.com", client.URL)
+		assert.Same(t, transport, client.Transport)
+	})
+
+	t.Run("dependencies are initialized before the factory", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideAssistedClientFactory(), pal.Provide(&sharedTransport{}))
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.True(t, p.Container().Graph().EdgeExists(
+			pal.ServiceName[*assistedClient](),
+			pal.ServiceName[*sharedTransport](),
+		))
+	})
+
+	t.Run("when a named dependency is missing, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		type namedDeps struct {
+			Transport *sharedTransport `pal:"name=transport"`
+		}
+
+		p := newPal(pal.ProvideAssistedFactory[*assistedClient](func(_ context.Context, url string, deps namedDeps) (*assistedClient, error) {
+			return &assistedClient{URL: url, Transport: deps.Transport}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.InvokeWith[*assistedClient](t.Context(), p, "https://example.com")
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+
+		_, err = p.Validate(t.Context())
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+
+	t.Run("panics when dependencies are not a struct", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() {
+			pal.ProvideAssistedFactory[*assistedClient](func(_ context.Context, url string, transport *sharedTransport) (*assistedClient, error) {
+				return &assistedClient{URL: url, Transport: transport}, nil
+			})
+		})
+	})
+}
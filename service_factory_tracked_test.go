@@ -0,0 +1,149 @@
+package pal_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type trackedSession struct {
+	name string
+	log  *shutdownLog
+	err  error
+}
+
+func (s *trackedSession) Shutdown(_ context.Context) error {
+	s.log.add(s.name)
+	return s.err
+}
+
+type trackedSessionPool struct {
+	log *shutdownLog
+}
+
+func (p *trackedSessionPool) Shutdown(_ context.Context) error {
+	p.log.add("pool")
+	return nil
+}
+
+type trackedSessionDeps struct {
+	Pool *trackedSessionPool
+}
+
+func provideTrackedSessions(log *shutdownLog) pal.FactoryDef {
+	return pal.ProvideAssistedFactory[*trackedSession](func(_ context.Context, name string, _ trackedSessionDeps) (*trackedSession, error) {
+		return &trackedSession{name: name, log: log}, nil
+	})
+}
+
+func TestServiceFactory_Tracked(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shuts down instances in reverse creation order before the factory dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(provideTrackedSessions(log).Tracked(), pal.Provide(&trackedSessionPool{log: log}))
+		require.NoError(t, p.Init(t.Context()))
+
+		for _, name := range []string{"first", "second", "third"} {
+			_, err := pal.InvokeWith[*trackedSession](t.Context(), p, name)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+
+		assert.Equal(t, []string{"third", "second", "first", "pool"}, log.get())
+	})
+
+	t.Run("does not shut down instances of untracked factories", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(provideTrackedSessions(log), pal.Provide(&trackedSessionPool{log: log}))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.InvokeWith[*trackedSession](t.Context(), p, "first")
+		require.NoError(t, err)
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+
+		assert.Equal(t, []string{"pool"}, log.get())
+	})
+
+	t.Run("released instances are shut down immediately and only once", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(provideTrackedSessions(log).Tracked(), pal.Provide(&trackedSessionPool{log: log}))
+		require.NoError(t, p.Init(t.Context()))
+
+		first, err := pal.InvokeWith[*trackedSession](t.Context(), p, "first")
+		require.NoError(t, err)
+		_, err = pal.InvokeWith[*trackedSession](t.Context(), p, "second")
+		require.NoError(t, err)
+
+		require.NoError(t, pal.Release(t.Context(), p, first))
+		assert.Equal(t, []string{"first"}, log.get())
+
+		assert.ErrorIs(t, pal.Release(t.Context(), p, first), pal.ErrNotTracked)
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+		assert.Equal(t, []string{"first", "second", "pool"}, log.get())
+	})
+
+	t.Run("shuts down all instances and reports errors", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(pal.ProvideFactory1[*trackedSession](func(_ context.Context, name string) (*trackedSession, error) {
+			session := &trackedSession{name: name, log: log}
+			if name == "failing" {
+				session.err = errTest
+			}
+			return session, nil
+		}).Tracked())
+		require.NoError(t, p.Init(t.Context()))
+
+		for _, name := range []string{"first", "failing", "last"} {
+			_, err := pal.Invoke[*trackedSession](t.Context(), p, name)
+			require.NoError(t, err)
+		}
+
+		err := p.Container().Shutdown(t.Context())
+
+		require.ErrorIs(t, err, errTest)
+		assert.Equal(t, []string{"last", "failing", "first"}, log.get())
+	})
+
+	t.Run("returns an error when releasing an instance that is not tracked", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(provideTrackedSessions(&shutdownLog{}).Tracked(), pal.Provide(&trackedSessionPool{}))
+		require.NoError(t, p.Init(t.Context()))
+
+		assert.ErrorIs(t, pal.Release(t.Context(), p, &trackedSession{}), pal.ErrNotTracked)
+	})
+
+	t.Run("releases instances invoked within a scope", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(provideTrackedSessions(log).Tracked(), pal.Provide(&trackedSessionPool{log: log}))
+		require.NoError(t, p.Init(t.Context()))
+
+		ctx, scope := p.NewScope(t.Context())
+		session, err := pal.InvokeWith[*trackedSession](ctx, scope, "scoped")
+		require.NoError(t, err)
+
+		require.NoError(t, pal.Release(ctx, scope, session))
+		assert.Equal(t, []string{"scoped"}, log.get())
+
+		require.NoError(t, scope.Close(ctx))
+		assert.ErrorIs(t, pal.Release(ctx, scope, session), pal.ErrNotTracked)
+	})
+}
@@ -2,6 +2,7 @@ package pal
 
 import (
 	"context"
+	"time"
 )
 
 // ServiceFnSingleton is a singleton service that is created using a function.
@@ -35,27 +36,27 @@ func (c *ServiceFnSingleton[I, T]) Run(ctx context.Context) error {
 // Init creates the singleton via the factory function, then runs the same pipeline as
 // [ServiceConst.Init]: inject dependencies, then ToInit / PalInit / Init.
 func (c *ServiceFnSingleton[I, T]) Init(ctx context.Context) error {
-	instance, err := c.fn(ctx)
+	instance, err := c.build(ctx, c.fn, c.hooks)
 	if err != nil {
 		return err
 	}
 
-	if err := initService(ctx, c.Name(), instance, c.hooks.Init, c.P); err != nil {
-		return err
-	}
-
 	c.instance = instance
 	return nil
 }
 
 // HealthCheck performs a health check on the service if it implements the HealthChecker interface.
 func (c *ServiceFnSingleton[I, T]) HealthCheck(ctx context.Context) error {
-	return healthcheckService(ctx, c.Name(), c.instance, c.hooks.HealthCheck, c.P)
+	return healthcheckService(ctx, c.Name(), c.instance, c.hooks.HealthCheck, c.timeouts.HealthCheck, c.P)
+}
+
+func (c *ServiceFnSingleton[I, T]) healthCheckable() bool {
+	return hasHealthCheck(c.instance, c.hooks.HealthCheck)
 }
 
 // Shutdown gracefully shuts down the service if it implements the Shutdowner interface.
 func (c *ServiceFnSingleton[I, T]) Shutdown(ctx context.Context) error {
-	return shutdownService(ctx, c.Name(), c.instance, c.hooks.Shutdown, c.P)
+	return shutdownService(ctx, c.Name(), c.instance, c.hooks.Shutdown, c.timeouts.Shutdown, c.P)
 }
 
 // Instance returns the singleton instance of the service.
@@ -84,3 +85,27 @@ func (c *ServiceFnSingleton[I, T]) ToHealthCheck(hook LifecycleHook[T]) Hookable
 	c.hooks.HealthCheck = hook
 	return c
 }
+
+// WithInitTimeout sets the timeout of the service initialization, see [Config.InitTimeout].
+func (c *ServiceFnSingleton[I, T]) WithInitTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of the service shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceFnSingleton[I, T]) WithShutdownTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of the service health check, see [Config.HealthCheckTimeout].
+func (c *ServiceFnSingleton[I, T]) WithHealthCheckTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of the service according to the policy, see [RetryPolicy].
+func (c *ServiceFnSingleton[I, T]) WithInitRetry(policy RetryPolicy) Hookable[T] {
+	c.initRetry = &policy
+	return c
+}
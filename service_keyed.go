@@ -0,0 +1,192 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ServiceKeyed is a multiton service, it creates one instance per key and reuses it for subsequent invocations
+// with the same key. Cached instances are health checked and shut down along with other services.
+//
+// Advanced: prefer [ProvideKeyed]; this type remains exported for power users.
+type ServiceKeyed[I any, T any, K comparable] struct {
+	ServiceFactory[I, T]
+	fn func(ctx context.Context, key K) (T, error)
+
+	mu      sync.Mutex
+	entries map[K]*keyedEntry[T]
+	// created holds successfully created instances in creation order.
+	created []T
+	closed  bool
+}
+
+// keyedEntry is an instance cached for a key, done is closed when the instance is created or creation fails.
+type keyedEntry[T any] struct {
+	done     chan struct{}
+	instance T
+	err      error
+}
+
+func (c *ServiceKeyed[I, T, K]) Arguments() int {
+	return 1
+}
+
+// Instance returns the instance cached for the key, creating it with the provided function on first use.
+// Concurrent invocations with the same key wait for a single instance to be created, failed creations are not cached.
+func (c *ServiceKeyed[I, T, K]) Instance(ctx context.Context, args ...any) (any, error) {
+	key, ok := args[0].(K)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T, expected %T", ErrServiceInvalidArgumentType, args[0], key)
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("%w: '%s'", ErrServiceClosed, c.Name())
+	}
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-entry.done:
+			if entry.err != nil {
+				return nil, entry.err
+			}
+			return entry.instance, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.entries == nil {
+		c.entries = map[K]*keyedEntry[T]{}
+	}
+	entry := &keyedEntry[T]{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	defer close(entry.done)
+
+	entry.instance, entry.err = c.build(ctx, func(ctx context.Context) (T, error) {
+		return c.fn(ctx, key)
+	}, lifecycleHooks[T]{})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry.err != nil {
+		delete(c.entries, key)
+		return nil, entry.err
+	}
+	if c.closed {
+		// The service was shut down while the instance was being created, it is not cached.
+		entry.err = errors.Join(fmt.Errorf("%w: '%s'", ErrServiceClosed, c.Name()),
+			shutdownService(ctx, c.Name(), entry.instance, nil, c.timeouts.Shutdown, c.P))
+		return nil, entry.err
+	}
+	c.created = append(c.created, entry.instance)
+
+	return entry.instance, nil
+}
+
+// Factory returns a function that returns the instance cached for the key.
+// The returned function has the signature func(ctx context.Context, key K) (I, error).
+func (c *ServiceKeyed[I, T, K]) Factory() any {
+	return func(ctx context.Context, key K) (I, error) {
+		instance, err := c.Instance(ctx, key)
+		if err != nil {
+			var i I
+			return i, err
+		}
+		return instance.(I), nil
+	}
+}
+
+// MustFactory returns a function that returns the instance cached for the key.
+// The returned function has the signature func(ctx context.Context, key K) I.
+// If the instance creation fails, it panics.
+func (c *ServiceKeyed[I, T, K]) MustFactory() any {
+	return func(ctx context.Context, key K) I {
+		return must(c.Instance(ctx, key)).(I)
+	}
+}
+
+// HealthCheck health checks all cached instances, the errors are joined.
+func (c *ServiceKeyed[I, T, K]) HealthCheck(ctx context.Context) error {
+	c.mu.Lock()
+	created := slices.Clone(c.created)
+	c.mu.Unlock()
+
+	errs := []error{}
+	for _, instance := range created {
+		if err := healthcheckService(ctx, c.Name(), instance, nil, c.timeouts.HealthCheck, c.P); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *ServiceKeyed[I, T, K]) healthCheckable() bool {
+	if hasHealthCheck(empty[T](), nil) {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.ContainsFunc(c.created, func(instance T) bool { return hasHealthCheck(instance, nil) })
+}
+
+// Shutdown shuts down all cached instances in reverse creation order and clears the cache.
+// All instances are shut down even if some of them fail, the errors are joined.
+// Invocations after shutdown get [ErrServiceClosed], instances created concurrently with shutdown are shut down.
+func (c *ServiceKeyed[I, T, K]) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	created := c.created
+	c.created = nil
+	c.entries = nil
+	c.mu.Unlock()
+
+	errs := []error{}
+	for _, instance := range slices.Backward(created) {
+		if err := shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// WithInitTimeout sets the timeout of cached instances initialization, see [Config.InitTimeout].
+func (c *ServiceKeyed[I, T, K]) WithInitTimeout(timeout time.Duration) KeyedDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of cached instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceKeyed[I, T, K]) WithShutdownTimeout(timeout time.Duration) KeyedDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of cached instances health check, see [Config.HealthCheckTimeout].
+func (c *ServiceKeyed[I, T, K]) WithHealthCheckTimeout(timeout time.Duration) KeyedDef {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of cached instances according to the policy, see [RetryPolicy].
+func (c *ServiceKeyed[I, T, K]) WithInitRetry(policy RetryPolicy) KeyedDef {
+	c.initRetry = &policy
+	return c
+}
@@ -0,0 +1,221 @@
+package pal_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type regionClient struct {
+	region      string
+	log         *shutdownLog
+	initialized bool
+	healthErr   error
+}
+
+func (c *regionClient) Init(_ context.Context) error {
+	c.initialized = true
+	return nil
+}
+
+func (c *regionClient) HealthCheck(_ context.Context) error {
+	return c.healthErr
+}
+
+func (c *regionClient) Shutdown(_ context.Context) error {
+	c.log.add(c.region)
+	return nil
+}
+
+type serviceWithKeyedDependency struct {
+	Client func(ctx context.Context, region string) (*regionClient, error)
+}
+
+// TestServiceKeyed_Invocation tests invocation of keyed services
+func TestServiceKeyed_Invocation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when invoked with the same key, returns the cached instance", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		consumer := &serviceWithKeyedDependency{}
+		p := newPal(
+			pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+				calls.Add(1)
+				return &regionClient{region: region}, nil
+			}),
+			pal.Provide(consumer),
+		)
+		require.NoError(t, p.Init(t.Context()))
+
+		eu1, err := consumer.Client(t.Context(), "eu")
+		require.NoError(t, err)
+		assert.True(t, eu1.initialized)
+
+		eu2, err := pal.Invoke[*regionClient](t.Context(), p, "eu")
+		require.NoError(t, err)
+		assert.Same(t, eu1, eu2)
+
+		us, err := consumer.Client(t.Context(), "us")
+		require.NoError(t, err)
+		assert.NotSame(t, eu1, us)
+
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("when invoked concurrently with the same key, creates a single instance", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			calls.Add(1)
+			return &regionClient{region: region}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		clients := make([]*regionClient, 10)
+		wg := sync.WaitGroup{}
+		for i := range clients {
+			wg.Go(func() {
+				clients[i] = pal.MustInvoke[*regionClient](t.Context(), p, "eu")
+			})
+		}
+		wg.Wait()
+
+		for _, client := range clients {
+			assert.Same(t, clients[0], client)
+		}
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("when creation fails, does not cache the failure", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, _ string) (*regionClient, error) {
+			calls.Add(1)
+			return nil, errTest
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.Invoke[*regionClient](t.Context(), p, "eu")
+		require.ErrorIs(t, err, errTest)
+
+		_, err = pal.Invoke[*regionClient](t.Context(), p, "eu")
+		require.ErrorIs(t, err, errTest)
+
+		assert.EqualValues(t, 2, calls.Load())
+	})
+
+	t.Run("when invoked with incorrect key type, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			return &regionClient{region: region}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.Invoke[*regionClient](t.Context(), p, 1)
+
+		assert.ErrorIs(t, err, pal.ErrServiceInvalidArgumentType)
+	})
+
+	t.Run("when invoked after shutdown, returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		var calls atomic.Int32
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			calls.Add(1)
+			return &regionClient{region: region, log: &shutdownLog{}}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.Invoke[*regionClient](t.Context(), p, "eu")
+		require.NoError(t, err)
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+
+		_, err = pal.Invoke[*regionClient](t.Context(), p, "eu")
+
+		require.ErrorIs(t, err, pal.ErrServiceClosed)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+}
+
+// TestServiceKeyed_HealthCheck tests the HealthCheck method of keyed services
+func TestServiceKeyed_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("health checks cached instances", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			return &regionClient{region: region}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		client, err := pal.Invoke[*regionClient](t.Context(), p, "eu")
+		require.NoError(t, err)
+		require.NoError(t, p.HealthCheck(t.Context()))
+
+		client.healthErr = errTest
+
+		assert.ErrorIs(t, p.HealthCheck(t.Context()), errTest)
+	})
+}
+
+// TestServiceKeyed_Shutdown tests the Shutdown method of keyed services
+func TestServiceKeyed_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("shuts down cached instances in reverse creation order", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			return &regionClient{region: region, log: log}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		for _, region := range []string{"eu", "us", "eu", "ap"} {
+			_, err := pal.Invoke[*regionClient](t.Context(), p, region)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+		assert.Equal(t, []string{"ap", "us", "eu"}, log.get())
+	})
+
+	t.Run("shuts down instances created concurrently with shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		creating := make(chan struct{})
+		proceed := make(chan struct{})
+		p := newPal(pal.ProvideKeyed[*regionClient](func(_ context.Context, region string) (*regionClient, error) {
+			close(creating)
+			<-proceed
+			return &regionClient{region: region, log: log}, nil
+		}))
+		require.NoError(t, p.Init(t.Context()))
+
+		errs := make(chan error)
+		go func() {
+			_, err := pal.Invoke[*regionClient](t.Context(), p, "eu")
+			errs <- err
+		}()
+
+		<-creating
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+		close(proceed)
+
+		require.ErrorIs(t, <-errs, pal.ErrServiceClosed)
+		assert.Equal(t, []string{"eu"}, log.get())
+	})
+}
@@ -0,0 +1,282 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ServicePool is a service that keeps up to a given number of initialized instances and hands them out to callers.
+// Instances are created on demand, acquired with an injected func(ctx context.Context) (I, func(), error) function
+// and returned to the pool by calling the returned release function.
+//
+// Advanced: prefer [ProvidePool]; this type remains exported for power users.
+type ServicePool[I any, T any] struct {
+	ServiceFactory[I, T]
+	fn   func(ctx context.Context) (T, error)
+	size int
+
+	nonBlocking bool
+
+	// slots holds a token for every instance that is acquired or is being health checked.
+	slots chan struct{}
+	// done is closed when the pool is shut down, it wakes up callers waiting for an instance.
+	done chan struct{}
+
+	mu sync.Mutex
+	// instances holds all instances created by the pool in creation order, idle holds those that are not acquired.
+	instances []T
+	idle      []T
+	closed    bool
+}
+
+// pooledService is implemented by pooled services, their instances can only be acquired with an acquire function.
+type pooledService interface {
+	pooled()
+}
+
+func newServicePool[I any, T any](name string, size int, fn func(ctx context.Context) (T, error)) *ServicePool[I, T] {
+	return &ServicePool[I, T]{
+		ServiceFactory: ServiceFactory[I, T]{ServiceTyped: ServiceTyped[I]{name: name}},
+		fn:             fn,
+		size:           size,
+		slots:          make(chan struct{}, size),
+		done:           make(chan struct{}),
+	}
+}
+
+func (c *ServicePool[I, T]) pooled() {}
+
+// Instance returns an error, instances of pooled services must be acquired with an acquire function,
+// so they are returned to the pool.
+func (c *ServicePool[I, T]) Instance(_ context.Context, _ ...any) (any, error) {
+	return nil, fmt.Errorf("%w: pooled service '%s' must be acquired with an injected acquire function", ErrServiceInvalid, c.Name())
+}
+
+// Factory returns a function that acquires an instance from the pool.
+// The returned function has the signature func(ctx context.Context) (I, func(), error),
+// the returned func() returns the instance to the pool, it is safe to call it multiple times.
+func (c *ServicePool[I, T]) Factory() any {
+	return c.acquire
+}
+
+// MustFactory returns a function that acquires an instance from the pool.
+// The returned function has the signature func(ctx context.Context) (I, func()).
+// If the instance cannot be acquired, it panics.
+func (c *ServicePool[I, T]) MustFactory() any {
+	return func(ctx context.Context) (I, func()) {
+		instance, release, err := c.acquire(ctx)
+		if err != nil {
+			panic(err)
+		}
+		return instance, release
+	}
+}
+
+// NonBlocking makes the acquire function fail with [ErrPoolExhausted] instead of waiting when all instances are in use.
+func (c *ServicePool[I, T]) NonBlocking() PoolDef {
+	c.nonBlocking = true
+	return c
+}
+
+// HealthCheck health checks idle instances, unhealthy instances are shut down and removed from the pool,
+// they are replaced with new instances when needed. Instances that are acquired are not checked.
+func (c *ServicePool[I, T]) HealthCheck(ctx context.Context) error {
+	c.mu.Lock()
+	idle := slices.Clone(c.idle)
+	c.mu.Unlock()
+
+	errs := []error{}
+	for _, instance := range idle {
+		// The instance being checked occupies a slot, so the pool does not create a replacement meanwhile.
+		select {
+		case c.slots <- struct{}{}:
+		default:
+			continue
+		}
+
+		if !c.take(instance) {
+			<-c.slots
+			continue
+		}
+
+		err := healthcheckService(ctx, c.Name(), instance, nil, c.timeouts.HealthCheck, c.P)
+
+		c.mu.Lock()
+		if err == nil && !c.closed {
+			c.idle = append(c.idle, instance)
+			c.mu.Unlock()
+			<-c.slots
+			continue
+		}
+		c.instances = slices.DeleteFunc(c.instances, func(created T) bool { return any(created) == any(instance) })
+		c.mu.Unlock()
+		<-c.slots
+
+		if err != nil {
+			errs = append(errs, err)
+			if err := shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *ServicePool[I, T]) healthCheckable() bool {
+	if hasHealthCheck(empty[T](), nil) {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.ContainsFunc(c.instances, func(instance T) bool { return hasHealthCheck(instance, nil) })
+}
+
+// Shutdown shuts down all instances created by the pool in reverse creation order, including acquired ones.
+// All instances are shut down even if some of them fail, the errors are joined.
+// Callers waiting for an instance get [ErrPoolClosed].
+func (c *ServicePool[I, T]) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	close(c.done)
+	instances := c.instances
+	c.instances = nil
+	c.idle = nil
+	c.mu.Unlock()
+
+	errs := []error{}
+	for _, instance := range slices.Backward(instances) {
+		if err := shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// acquire returns an idle instance or creates a new one if there are no idle instances and the pool is not full.
+func (c *ServicePool[I, T]) acquire(ctx context.Context) (I, func(), error) {
+	if err := c.reserve(ctx); err != nil {
+		return empty[I](), nil, err
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		<-c.slots
+		return empty[I](), nil, fmt.Errorf("%w: '%s'", ErrPoolClosed, c.Name())
+	}
+	if n := len(c.idle); n > 0 {
+		instance := c.idle[n-1]
+		c.idle = c.idle[:n-1]
+		c.mu.Unlock()
+		return any(instance).(I), c.releaser(instance), nil
+	}
+	c.mu.Unlock()
+
+	instance, err := c.create(ctx)
+	if err != nil {
+		<-c.slots
+		return empty[I](), nil, err
+	}
+
+	return any(instance).(I), c.releaser(instance), nil
+}
+
+// reserve occupies a slot for an instance, waiting for one to be released if all instances are in use.
+func (c *ServicePool[I, T]) reserve(ctx context.Context) error {
+	if c.nonBlocking {
+		select {
+		case c.slots <- struct{}{}:
+			return nil
+		default:
+			return fmt.Errorf("%w: '%s': all %d instances are in use", ErrPoolExhausted, c.Name(), c.size)
+		}
+	}
+
+	select {
+	case c.slots <- struct{}{}:
+		return nil
+	case <-c.done:
+		return fmt.Errorf("%w: '%s'", ErrPoolClosed, c.Name())
+	case <-ctx.Done():
+		return fmt.Errorf("%w: '%s': %w", ErrPoolExhausted, c.Name(), ctx.Err())
+	}
+}
+
+// create creates and initializes a new instance and adds it to the pool.
+func (c *ServicePool[I, T]) create(ctx context.Context) (T, error) {
+	instance, err := c.build(ctx, c.fn, lifecycleHooks[T]{})
+	if err != nil {
+		return empty[T](), fmt.Errorf("%w: '%s': %w", ErrServiceInitFailed, c.Name(), err)
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return empty[T](), errors.Join(fmt.Errorf("%w: '%s'", ErrPoolClosed, c.Name()), shutdownService(ctx, c.Name(), instance, nil, c.timeouts.Shutdown, c.P))
+	}
+	c.instances = append(c.instances, instance)
+	c.mu.Unlock()
+
+	return instance, nil
+}
+
+// take removes the instance from idle instances, it returns false if the instance is not idle.
+func (c *ServicePool[I, T]) take(instance T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	index := slices.IndexFunc(c.idle, func(idle T) bool { return any(idle) == any(instance) })
+	if index < 0 {
+		return false
+	}
+	c.idle = slices.Delete(c.idle, index, index+1)
+	return true
+}
+
+// releaser returns a function returning the instance to the pool, instances released after shutdown are dropped.
+func (c *ServicePool[I, T]) releaser(instance T) func() {
+	return sync.OnceFunc(func() {
+		c.mu.Lock()
+		if !c.closed {
+			c.idle = append(c.idle, instance)
+		}
+		c.mu.Unlock()
+		<-c.slots
+	})
+}
+
+// WithInitTimeout sets the timeout of pooled instances initialization, see [Config.InitTimeout].
+func (c *ServicePool[I, T]) WithInitTimeout(timeout time.Duration) PoolDef {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of pooled instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServicePool[I, T]) WithShutdownTimeout(timeout time.Duration) PoolDef {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of pooled instances health check, see [Config.HealthCheckTimeout].
+func (c *ServicePool[I, T]) WithHealthCheckTimeout(timeout time.Duration) PoolDef {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of pooled instances according to the policy, see [RetryPolicy].
+func (c *ServicePool[I, T]) WithInitRetry(policy RetryPolicy) PoolDef {
+	c.initRetry = &policy
+	return c
+}
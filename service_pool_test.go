@@ -0,0 +1,197 @@
+package pal_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type pooledParser struct {
+	name        string
+	log         *shutdownLog
+	initialized bool
+	healthErr   error
+}
+
+func (p *pooledParser) Init(_ context.Context) error {
+	p.initialized = true
+	return nil
+}
+
+func (p *pooledParser) HealthCheck(_ context.Context) error {
+	return p.healthErr
+}
+
+func (p *pooledParser) Shutdown(_ context.Context) error {
+	p.log.add(p.name)
+	return nil
+}
+
+type serviceWithPoolDependency struct {
+	Acquire func(ctx context.Context) (*pooledParser, func(), error)
+}
+
+func providePooledParsers(log *shutdownLog, size int) pal.PoolDef {
+	var created atomic.Int32
+	return pal.ProvidePool[*pooledParser](func(_ context.Context) (*pooledParser, error) {
+		return &pooledParser{name: fmt.Sprintf("parser%d", created.Add(1)), log: log}, nil
+	}, size)
+}
+
+func TestServicePool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reuses released instances", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &serviceWithPoolDependency{}
+		p := newPal(providePooledParsers(&shutdownLog{}, 2), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		parser1, release1, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.True(t, parser1.initialized)
+
+		parser2, release2, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.NotSame(t, parser1, parser2)
+
+		release1()
+		release1()
+
+		parser3, release3, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.Same(t, parser1, parser3)
+
+		release2()
+		release3()
+	})
+
+	t.Run("waits for an instance to be released when all instances are in use", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &serviceWithPoolDependency{}
+		p := newPal(providePooledParsers(&shutdownLog{}, 1), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		parser, release, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+
+		_, _, err = consumer.Acquire(ctx)
+		require.ErrorIs(t, err, pal.ErrPoolExhausted)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		time.AfterFunc(10*time.Millisecond, release)
+
+		acquired, _, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		assert.Same(t, parser, acquired)
+	})
+
+	t.Run("when non-blocking, returns an error when all instances are in use", func(t *testing.T) {
+		t.Parallel()
+
+		consumer := &serviceWithPoolDependency{}
+		p := newPal(providePooledParsers(&shutdownLog{}, 1).NonBlocking(), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, _, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+
+		_, _, err = consumer.Acquire(t.Context())
+		assert.ErrorIs(t, err, pal.ErrPoolExhausted)
+	})
+
+	t.Run("shuts down unhealthy idle instances", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		consumer := &serviceWithPoolDependency{}
+		p := newPal(providePooledParsers(log, 2), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		parser1, release1, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		parser2, release2, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+
+		parser1.healthErr = errTest
+		release1()
+		release2()
+
+		require.ErrorIs(t, p.HealthCheck(t.Context()), errTest)
+		assert.Equal(t, []string{"parser1"}, log.get())
+
+		require.NoError(t, p.HealthCheck(t.Context()))
+
+		acquired1, _, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		acquired2, _, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+
+		assert.Same(t, parser2, acquired1)
+		assert.Equal(t, "parser3", acquired2.name)
+	})
+
+	t.Run("shuts down all instances in reverse creation order", func(t *testing.T) {
+		t.Parallel()
+
+		log := &shutdownLog{}
+		consumer := &serviceWithPoolDependency{}
+		p := newPal(providePooledParsers(log, 3), pal.Provide(consumer))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, release, err := consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		_, _, err = consumer.Acquire(t.Context())
+		require.NoError(t, err)
+		release()
+
+		require.NoError(t, p.Container().Shutdown(t.Context()))
+		assert.Equal(t, []string{"parser2", "parser1"}, log.get())
+
+		_, _, err = consumer.Acquire(t.Context())
+		assert.ErrorIs(t, err, pal.ErrPoolClosed)
+	})
+
+	t.Run("cannot be invoked directly", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(providePooledParsers(&shutdownLog{}, 1))
+		require.NoError(t, p.Init(t.Context()))
+
+		_, err := pal.Invoke[*pooledParser](t.Context(), p)
+		assert.ErrorIs(t, err, pal.ErrServiceInvalid)
+	})
+
+	t.Run("cannot be injected directly", func(t *testing.T) {
+		t.Parallel()
+
+		type consumer struct {
+			Parser *pooledParser
+		}
+
+		p := newPal(providePooledParsers(&shutdownLog{}, 1), pal.Provide(&consumer{}))
+
+		_, err := p.Validate(t.Context())
+		assert.ErrorIs(t, err, pal.ErrServiceInvalid)
+	})
+
+	t.Run("panics when size is not positive", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Panics(t, func() {
+			providePooledParsers(&shutdownLog{}, 0)
+		})
+	})
+}
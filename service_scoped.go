@@ -0,0 +1,92 @@
+package pal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ServiceScoped is a service that is created using a function once per [Scope].
+// Invoking it outside of a scope results in [ErrScopeIsNotInContext].
+//
+// Advanced: prefer [ProvideScoped] / [Hookable] for normal registration; this type remains exported for power users.
+type ServiceScoped[I, T any] struct {
+	ServiceFactory[I, T]
+	fn func(ctx context.Context) (T, error)
+
+	hooks lifecycleHooks[T]
+}
+
+// Instance returns the instance bound to the scope found in the context, creating it on first call.
+// The instance is created by the function, then goes through the same pipeline as [ServiceFnSingleton.Init]:
+// inject dependencies, then ToInit / PalInit / Init.
+func (c *ServiceScoped[I, T]) Instance(ctx context.Context, _ ...any) (any, error) {
+	scope, err := ScopeFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: scoped service '%s' must be invoked within a scope", err, c.Name())
+	}
+
+	return scope.instance(ctx, c.Name(), func(ctx context.Context) (any, func(ctx context.Context) error, error) {
+		instance, err := c.build(ctx, c.fn, c.hooks)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		shutdown := func(ctx context.Context) error {
+			if err := shutdownService(ctx, c.Name(), instance, c.hooks.Shutdown, c.timeouts.Shutdown, c.P); err != nil {
+				return &ServiceError{Service: c.Name(), Phase: PhaseShutdown, Err: err}
+			}
+			return nil
+		}
+
+		return instance, shutdown, nil
+	})
+}
+
+func (c *ServiceScoped[I, T]) scoped() {}
+
+// ToInit registers a hook called after the function creates the scoped instance and
+// dependencies are injected. If the service implements [PalIniter] or [Initer], those
+// methods are not called; the hook has higher priority.
+func (c *ServiceScoped[I, T]) ToInit(hook LifecycleHook[T]) Hookable[T] {
+	c.hooks.Init = hook
+	return c
+}
+
+// ToShutdown registers a hook called when the scope is closed. If the service implements [PalShutdowner] or [Shutdowner],
+// those methods are not called; the hook has higher priority.
+func (c *ServiceScoped[I, T]) ToShutdown(hook LifecycleHook[T]) Hookable[T] {
+	c.hooks.Shutdown = hook
+	return c
+}
+
+// ToHealthCheck registers a health check hook. Scoped instances are short-lived and are not health checked,
+// the hook is stored only to satisfy [Hookable].
+func (c *ServiceScoped[I, T]) ToHealthCheck(hook LifecycleHook[T]) Hookable[T] {
+	c.hooks.HealthCheck = hook
+	return c
+}
+
+// WithInitTimeout sets the timeout of scoped instances initialization, see [Config.InitTimeout].
+func (c *ServiceScoped[I, T]) WithInitTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Init = timeout
+	return c
+}
+
+// WithShutdownTimeout sets the timeout of scoped instances shutdown, see [Config.ShutdownTimeout].
+func (c *ServiceScoped[I, T]) WithShutdownTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.Shutdown = timeout
+	return c
+}
+
+// WithHealthCheckTimeout sets the timeout of scoped instances health check, see [Config.HealthCheckTimeout].
+func (c *ServiceScoped[I, T]) WithHealthCheckTimeout(timeout time.Duration) Hookable[T] {
+	c.timeouts.HealthCheck = timeout
+	return c
+}
+
+// WithInitRetry retries initialization of scoped instances according to the policy, see [RetryPolicy].
+func (c *ServiceScoped[I, T]) WithInitRetry(policy RetryPolicy) Hookable[T] {
+	c.initRetry = &policy
+	return c
+}
@@ -1,11 +1,21 @@
 package pal
 
+import (
+	"reflect"
+
+	typetostring "github.com/samber/go-type-to-string"
+)
+
 // ServiceTyped is a shared base for Provide* wrappers.
 //
 // Advanced: exported for embedding/custom ServiceDef implementations.
 type ServiceTyped[T any] struct {
-	P    *Pal
-	name string
+	P     *Pal
+	name  string
+	group string
+
+	timeouts  lifecycleTimeouts
+	initRetry *RetryPolicy
 }
 
 func (c *ServiceTyped[T]) Dependencies() []ServiceDef {
@@ -27,6 +37,21 @@ func (c *ServiceTyped[T]) Name() string {
 	return c.name
 }
 
+// serviceType returns the type the service is registered as.
+func (c *ServiceTyped[T]) serviceType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// explicitlyNamed reports whether the service is registered with a name given to a Named constructor
+// rather than the name derived from its type.
+func (c *ServiceTyped[T]) explicitlyNamed() bool {
+	return c.name != typetostring.GetType[T]()
+}
+
+func (c *ServiceTyped[T]) groupName() string {
+	return c.group
+}
+
 func (c *ServiceTyped[T]) Arguments() int {
 	return 0
 }
@@ -3,6 +3,7 @@ package pal
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 func runService(ctx context.Context, name string, instance any, p *Pal) error {
@@ -42,7 +43,10 @@ func runService(ctx context.Context, name string, instance any, p *Pal) error {
 	return err
 }
 
-func healthcheckService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], p *Pal) error {
+func healthcheckService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], timeout time.Duration, p *Pal) error {
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
 	logger := p.logger.With("service", name)
 	if hook != nil {
 		logger.Debug("Calling ToHealthCheck hook")
@@ -75,7 +79,10 @@ func healthcheckService[T any](ctx context.Context, name string, instance T, hoo
 	return nil
 }
 
-func shutdownService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], p *Pal) error {
+func shutdownService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], timeout time.Duration, p *Pal) error {
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
 	logger := p.logger.With("service", name)
 	if hook != nil {
 		logger.Debug("Calling ToShutdown hook")
@@ -108,7 +115,10 @@ func shutdownService[T any](ctx context.Context, name string, instance T, hook L
 	return nil
 }
 
-func initService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], p *Pal) error {
+func initService[T any](ctx context.Context, name string, instance T, hook LifecycleHook[T], timeout time.Duration, p *Pal) error {
+	ctx, cancel := withTimeout(withInitChain(ctx, name), timeout)
+	defer cancel()
+
 	logger := p.logger.With("service", name)
 
 	err := p.InjectInto(ctx, instance)
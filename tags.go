@@ -13,12 +13,25 @@ const (
 	TagSkip           Tag = "skip"
 	TagMatchInterface Tag = "match_interface"
 	TagName           Tag = "name"
+	TagGroup          Tag = "group"
+	TagNames          Tag = "names"
+	TagOptional       Tag = "optional"
+	TagConfig         Tag = "config"
 )
 
 var supportedTags = map[Tag]bool{
 	TagSkip:           true,
 	TagMatchInterface: true,
 	TagName:           true,
+	TagGroup:          true,
+	TagNames:          true,
+	TagOptional:       true,
+	TagConfig:         true,
+}
+
+// Supported reports whether the tag is recognized on `pal:"..."` field tags.
+func (t Tag) Supported() bool {
+	return supportedTags[t]
 }
 
 func parseTag(tags string) (map[Tag]string, error) {
@@ -32,7 +45,7 @@ func parseTag(tags string) (map[Tag]string, error) {
 		parts := strings.Split(tag, "=")
 		tagName := parts[0]
 
-		if !supportedTags[Tag(tagName)] {
+		if !Tag(tagName).Supported() {
 			return nil, fmt.Errorf("%w: tag unsupported %s", ErrInvalidTag, tagName)
 		}
 		switch len(parts) {
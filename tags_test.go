@@ -32,6 +32,40 @@ func TestParseTag(t *testing.T) {
 		}, tags)
 	})
 
+	t.Run("parses group tag", func(t *testing.T) {
+		t.Parallel()
+
+		tags, err := parseTag("group=routes")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[Tag]string{
+			TagGroup: "routes",
+		}, tags)
+	})
+
+	t.Run("parses names tag", func(t *testing.T) {
+		t.Parallel()
+
+		tags, err := parseTag("names=tenant-*")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[Tag]string{
+			TagNames: "tenant-*",
+		}, tags)
+	})
+
+	t.Run("parses optional tag", func(t *testing.T) {
+		t.Parallel()
+
+		tags, err := parseTag("name=MyService,optional")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[Tag]string{
+			TagName:     "MyService",
+			TagOptional: "",
+		}, tags)
+	})
+
 	t.Run("parses multiple tags without values", func(t *testing.T) {
 		t.Parallel()
 
@@ -169,3 +203,17 @@ func TestParseTag(t *testing.T) {
 		}, tags)
 	})
 }
+
+// TestTag_Supported tests the Supported method of Tag
+func TestTag_Supported(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports whether the tag is recognized", func(t *testing.T) {
+		t.Parallel()
+
+		for tag := range supportedTags {
+			assert.True(t, tag.Supported())
+		}
+		assert.False(t, Tag("unknown").Supported())
+	})
+}
@@ -26,10 +26,19 @@ type TreeNodeJSON struct {
 	Shutdowner    bool `json:"shutdowner"`
 }
 
+// Edge kinds reported in [TreeEdgeJSON.Kind].
+const (
+	// EdgeKindDependency is a regular dependency, it is initialized before the dependent service.
+	EdgeKindDependency = "dependency"
+	// EdgeKindLazy is a dependency injected as [Lazy], it does not affect initialization order.
+	EdgeKindLazy = "lazy"
+)
+
 // TreeEdgeJSON describes one dependency edge in [TreeJSON].
 type TreeEdgeJSON struct {
 	From string `json:"from"`
 	To   string `json:"to"`
+	Kind string `json:"kind"`
 }
 
 func serviceToTreeNodeJSON(id string, inDegree int, outDegree int, service ServiceDef) TreeNodeJSON {
@@ -91,6 +100,10 @@ func serviceToTreeNodeJSON(id string, inDegree int, outDegree int, service Servi
 // GraphToJSON encodes a dependency DAG as JSON.
 // Advanced: prefer [Pal.TreeJSON] unless you already hold a [ServiceGraph].
 func GraphToJSON(d *ServiceGraph) ([]byte, error) {
+	return graphToJSON(d, nil)
+}
+
+func graphToJSON(d *ServiceGraph, lazyEdges map[string]map[string]bool) ([]byte, error) {
 	var nodes []TreeNodeJSON
 	var edges []TreeEdgeJSON
 
@@ -103,6 +116,17 @@ func GraphToJSON(d *ServiceGraph) ([]byte, error) {
 			edges = append(edges, TreeEdgeJSON{
 				From: from,
 				To:   to,
+				Kind: EdgeKindDependency,
+			})
+		}
+	}
+
+	for from, targets := range lazyEdges {
+		for to := range targets {
+			edges = append(edges, TreeEdgeJSON{
+				From: from,
+				To:   to,
+				Kind: EdgeKindLazy,
 			})
 		}
 	}
@@ -113,7 +137,7 @@ func GraphToJSON(d *ServiceGraph) ([]byte, error) {
 	})
 }
 
-// TreeJSON returns a JSON encoding of this Pal instance's dependency graph.
+// TreeJSON returns a JSON encoding of this Pal instance's dependency graph, including [Lazy] dependencies.
 func (p *Pal) TreeJSON() ([]byte, error) {
-	return GraphToJSON(p.container.Graph())
+	return graphToJSON(p.container.Graph(), p.container.LazyEdges())
 }
@@ -0,0 +1,40 @@
+package pal_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+// TestPal_TreeJSON tests the TreeJSON method
+func TestPal_TreeJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("includes dependency and lazy edges", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&lazyCycleA{}), pal.Provide(&lazyCycleB{}))
+		require.NoError(t, p.Init(t.Context()))
+
+		data, err := p.TreeJSON()
+		require.NoError(t, err)
+
+		var tree pal.TreeJSON
+		require.NoError(t, json.Unmarshal(data, &tree))
+
+		assert.Contains(t, tree.Edges, pal.TreeEdgeJSON{
+			From: "*github.com/zhulik/pal_test.lazyCycleB",
+			To:   "*github.com/zhulik/pal_test.lazyCycleA",
+			Kind: pal.EdgeKindDependency,
+		})
+		assert.Contains(t, tree.Edges, pal.TreeEdgeJSON{
+			From: "*github.com/zhulik/pal_test.lazyCycleA",
+			To:   "*github.com/zhulik/pal_test.lazyCycleB",
+			Kind: pal.EdgeKindLazy,
+		})
+	})
+}
@@ -1,10 +1,12 @@
 package pal
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"runtime"
 	"strings"
+	"time"
 )
 
 func empty[T any]() T {
@@ -60,3 +62,34 @@ func backtrace(skipLastN int) string {
 
 	return stackTrace.String()
 }
+
+// isServiceMap reports whether t is a map[string]I with a non-empty interface I, such fields can be tagged with names.
+func isServiceMap(t reflect.Type) bool {
+	return t.Kind() == reflect.Map &&
+		t.Key().Kind() == reflect.String &&
+		t.Elem().Kind() == reflect.Interface &&
+		t.Elem().NumMethod() > 0
+}
+
+// matchName reports whether the name matches the pattern. An empty pattern matches any name,
+// a pattern ending with * matches names starting with the rest of the pattern, otherwise names must be equal.
+func matchName(pattern string, name string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(name, prefix)
+	}
+
+	return pattern == name
+}
+
+// withTimeout returns a context canceled after the timeout if it is positive, otherwise the context itself.
+// An earlier deadline of the parent context still applies.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,291 @@
+package pal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"reflect"
+	"slices"
+
+	typetostring "github.com/samber/go-type-to-string"
+
+	"github.com/zhulik/pal/internal/dag"
+)
+
+// typedService is implemented by services embedding [ServiceTyped], it returns the type the service is registered as.
+type typedService interface {
+	serviceType() reflect.Type
+}
+
+// namedService is implemented by services embedding [ServiceTyped], it reports whether the service is registered
+// with an explicit name.
+type namedService interface {
+	explicitlyNamed() bool
+}
+
+// scopedService is implemented by [ServiceScoped].
+type scopedService interface {
+	scoped()
+}
+
+// ValidationProblem is a wiring problem found by [Pal.Validate].
+type ValidationProblem struct {
+	// Service is the name of the service the problem was found in.
+	Service string
+	// Field is the name of the field the problem was found in, empty if the problem is not related to a field.
+	Field string
+	Err   error
+}
+
+func (p *ValidationProblem) Error() string {
+	if p.Field == "" {
+		return fmt.Sprintf("service '%s': %s", p.Service, p.Err)
+	}
+	return fmt.Sprintf("service '%s', field '%s': %s", p.Service, p.Field, p.Err)
+}
+
+func (p *ValidationProblem) Unwrap() error {
+	return p.Err
+}
+
+// ValidationReport is the result of [Pal.Validate].
+type ValidationReport struct {
+	// Problems holds all problems found, sorted by service name.
+	Problems []*ValidationProblem
+	// InitOrder holds names of services in the order they would be initialized in if InitConcurrency was 1.
+	// It is empty if the dependency graph could not be built.
+	InitOrder []string
+}
+
+// Err returns all problems joined with [errors.Join], or nil if there are none.
+func (r *ValidationReport) Err() error {
+	errs := make([]error, len(r.Problems))
+	for i, problem := range r.Problems {
+		errs[i] = problem
+	}
+	return errors.Join(errs...)
+}
+
+// Validate checks the wiring of the services without instantiating or initializing anything: it builds the
+// dependency graph from Make() results and checks every exported field against the registered services, factories
+// and tags. All problems found are returned at once in the report along with the init order. The returned error
+// is [ValidationReport.Err], or the config validation error.
+// It is meant to be called in a unit test of every binary.
+func (p *Pal) Validate(ctx context.Context) (*ValidationReport, error) {
+	if err := p.config.Validate(ctx); err != nil {
+		return nil, err
+	}
+
+	report := p.container.Validate(ctx)
+
+	return report, report.Err()
+}
+
+// Validate checks the wiring of the services without instantiating or initializing anything, see [Pal.Validate].
+// The container is not modified.
+func (c *Container) Validate(_ context.Context) *ValidationReport {
+	report := &ValidationReport{}
+
+	if err := c.checkDecorators(); err != nil {
+		report.Problems = append(report.Problems, &ValidationProblem{Err: err})
+	}
+
+	graph := dag.New[string, ServiceDef]()
+	lazyEdges := map[string]map[string]bool{}
+	graphValid := len(report.Problems) == 0
+
+	for _, name := range slices.Sorted(maps.Keys(c.services)) {
+		service := c.services[name]
+
+		report.Problems = append(report.Problems, c.validateService(name, service)...)
+
+		if !graphValid {
+			continue
+		}
+		if err := c.addDependencyVertex(graph, lazyEdges, service, nil); err != nil {
+			// Invalid tags are already reported by validateService.
+			if errors.Is(err, ErrCycleDetected) {
+				report.Problems = append(report.Problems, &ValidationProblem{Service: name, Err: err})
+			}
+			graphValid = false
+		}
+	}
+
+	if graphValid {
+		for name := range graph.ReverseTopologicalOrder() {
+			report.InitOrder = append(report.InitOrder, name)
+		}
+	}
+
+	return report
+}
+
+// validateService checks that the service's Make() result matches the type it is registered as,
+// and that all exported fields of the instance and of the parameters can be injected.
+func (c *Container) validateService(name string, service ServiceDef) []*ValidationProblem {
+	instance := service.Make()
+
+	if typed, ok := service.(typedService); ok && !isNil(instance) && !reflect.TypeOf(instance).AssignableTo(typed.serviceType()) {
+		return []*ValidationProblem{{
+			Service: name,
+			Err:     fmt.Errorf("%w: %T is not assignable to %s", ErrServiceInvalid, instance, typed.serviceType()),
+		}}
+	}
+
+	templates := []parameterTemplate{{instance: instance}}
+	if p, ok := service.(parameterized); ok {
+		templates = append(templates, p.parameterTemplates()...)
+	}
+
+	var decoratedName string
+	if d, ok := service.(decorator); ok {
+		decoratedName = d.decoratedName()
+	}
+	_, isScoped := service.(scopedService)
+
+	problems := []*ValidationProblem{}
+	for _, template := range templates {
+		if isNil(template.instance) {
+			continue
+		}
+
+		val := reflect.ValueOf(template.instance)
+		if val.Kind() == reflect.Pointer {
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			continue
+		}
+
+		typ := val.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			if err := c.validateField(field, val.Field(i), template.required, decoratedName, isScoped); err != nil {
+				problems = append(problems, &ValidationProblem{Service: name, Field: field.Name, Err: err})
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateField checks that the field would be injected by InjectInto without errors.
+// If required is true, the field must be resolvable even if it is not required by tags or strict mode.
+func (c *Container) validateField(field reflect.StructField, value reflect.Value, required bool, decoratedName string, isScoped bool) error {
+	tags, err := parseTag(field.Tag.Get("pal"))
+	if err != nil {
+		return err
+	}
+	if _, ok := tags[TagSkip]; ok {
+		return nil
+	}
+
+	fieldType := field.Type
+
+	// Without slog injection, loggers are resolved like any other field.
+	if fieldType == reflect.TypeOf((*slog.Logger)(nil)) && c.pal.config.AttrSetters != nil {
+		return nil
+	}
+
+	if isLazyField(fieldType) {
+		name := lazyFieldDependencyName(fieldType, tags)
+		if _, ok := c.services[name]; !ok {
+			return fmt.Errorf("%w: lazy dependency '%s'", ErrServiceNotFound, name)
+		}
+		return nil
+	}
+
+	if _, ok := tags[TagMatchInterface]; ok {
+		if fieldType.Kind() != reflect.Interface {
+			return fmt.Errorf("%w: must be an interface, got %s", ErrNotAnInterface, fieldType)
+		}
+
+		switch matches := c.servicesImplementing(fieldType); len(matches) {
+		case 0:
+			return fmt.Errorf("%w: no implementations of %s found", ErrServiceNotFound, fieldType)
+		case 1:
+			return nil
+		default:
+			return fmt.Errorf("%w: found %d services for interface %s", ErrMultipleServicesFoundByInterface, len(matches), fieldType)
+		}
+	}
+
+	if _, ok := tags[TagConfig]; ok {
+		switch providers := c.servicesImplementing(configProviderType); len(providers) {
+		case 0:
+			return fmt.Errorf("%w: config field requires a ConfigProvider", ErrServiceNotFound)
+		case 1:
+			return nil
+		default:
+			return fmt.Errorf("%w: found %d config providers", ErrMultipleServicesFoundByInterface, len(providers))
+		}
+	}
+
+	if group, ok := tags[TagGroup]; ok {
+		if fieldType.Kind() != reflect.Slice {
+			return fmt.Errorf("%w: group '%s' can only be injected into a slice, got %s", ErrInvalidTag, group, fieldType)
+		}
+
+		for _, member := range c.groups[group] {
+			if instance := member.Make(); !isNil(instance) && !reflect.TypeOf(instance).AssignableTo(fieldType.Elem()) {
+				return fmt.Errorf("%w: member '%s' of group '%s' is %T, expected %s", ErrServiceInvalid, member.Name(), group, instance, fieldType.Elem())
+			}
+		}
+		return nil
+	}
+
+	if _, ok := tags[TagNames]; ok {
+		if !isServiceMap(fieldType) {
+			return fmt.Errorf("%w: names can only be injected into a map[string]I, got %s", ErrInvalidTag, fieldType)
+		}
+		return nil
+	}
+
+	typeName := tags[TagName]
+	if typeName == "" {
+		typeName = typetostring.GetReflectType(fieldType)
+	}
+
+	if typeName == decoratedName {
+		return nil
+	}
+
+	if fieldType.Kind() == reflect.Func {
+		if _, ok := c.factories[typeName]; !ok && required {
+			return fmt.Errorf("%w: expects factory %s", ErrServiceNotFound, fieldType)
+		}
+		return nil
+	}
+
+	service, ok := c.services[typeName]
+	if !ok {
+		if required || c.requiresInjection(tags, value) {
+			return fmt.Errorf("%w: expects %s '%s'", ErrServiceNotFound, fieldType, typeName)
+		}
+		return nil
+	}
+
+	if service.Arguments() > 0 {
+		return fmt.Errorf("%w: '%s': %d arguments expected", ErrFactoryServiceDependency, typeName, service.Arguments())
+	}
+
+	if _, ok := service.(pooledService); ok {
+		return fmt.Errorf("%w: pooled service '%s' must be acquired with an acquire function", ErrServiceInvalid, typeName)
+	}
+
+	if _, ok := service.(scopedService); ok && !isScoped {
+		return fmt.Errorf("%w: scoped service '%s' cannot be a dependency of a singleton", ErrScopeIsNotInContext, typeName)
+	}
+
+	if instance := c.decorated(service).Make(); !isNil(instance) && !reflect.TypeOf(instance).AssignableTo(fieldType) {
+		return fmt.Errorf("%w: '%s' is %T, expected %s", ErrServiceInvalid, typeName, instance, fieldType)
+	}
+
+	return nil
+}
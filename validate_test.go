@@ -0,0 +1,140 @@
+package pal_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zhulik/pal"
+)
+
+type validatedLeaf struct {
+	initialized bool
+}
+
+func (v *validatedLeaf) Init(_ context.Context) error {
+	v.initialized = true
+	return nil
+}
+
+type validatedRoot struct {
+	Leaf *validatedLeaf
+}
+
+type miswiredService struct {
+	Missing    *validatedLeaf `pal:"name=missing"`
+	InvalidTag *validatedLeaf `pal:"unknown"`
+	Factory    *factory1Service
+	Scoped     *scopedRequest
+	Routes     groupRoute       `pal:"group=routes"`
+	Names      []storageBackend `pal:"names"`
+	Optional   *Pinger1
+}
+
+type validateCycleA struct {
+	B *validateCycleB
+}
+
+type validateCycleB struct {
+	A *validateCycleA
+}
+
+// TestPal_Validate tests the Validate method
+func TestPal_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns init order without initializing services", func(t *testing.T) {
+		t.Parallel()
+
+		leaf := &validatedLeaf{}
+
+		p := newPal(pal.Provide(&validatedRoot{}), pal.Provide(leaf))
+
+		report, err := p.Validate(t.Context())
+		require.NoError(t, err)
+
+		assert.Empty(t, report.Problems)
+		assert.Less(t,
+			slices.Index(report.InitOrder, "*github.com/zhulik/pal_test.validatedLeaf"),
+			slices.Index(report.InitOrder, "*github.com/zhulik/pal_test.validatedRoot"),
+		)
+		assert.False(t, leaf.initialized)
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.True(t, leaf.initialized)
+	})
+
+	t.Run("does not modify the container", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&lazyCycleA{}), pal.Provide(&lazyCycleB{}))
+
+		_, err := p.Validate(t.Context())
+		require.NoError(t, err)
+
+		assert.Zero(t, p.Container().Graph().VertexCount())
+		assert.Empty(t, p.Container().LazyEdges())
+
+		require.NoError(t, p.Init(t.Context()))
+		assert.NotEmpty(t, p.Container().LazyEdges())
+	})
+
+	t.Run("returns all problems at once", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(
+			pal.Provide(&miswiredService{}),
+			pal.ProvideFactory1[*factory1Service](func(_ context.Context, name string) (*factory1Service, error) {
+				return &factory1Service{Name: name}, nil
+			}),
+			pal.ProvideScoped[*scopedRequest](func(_ context.Context) (*scopedRequest, error) {
+				return &scopedRequest{}, nil
+			}),
+		)
+
+		report, err := p.Validate(t.Context())
+		require.Error(t, err)
+
+		fields := map[string]error{}
+		for _, problem := range report.Problems {
+			assert.Equal(t, "*github.com/zhulik/pal_test.miswiredService", problem.Service)
+			fields[problem.Field] = problem.Err
+		}
+
+		assert.Len(t, fields, 6)
+		assert.ErrorIs(t, fields["Missing"], pal.ErrServiceNotFound)
+		assert.ErrorIs(t, fields["InvalidTag"], pal.ErrInvalidTag)
+		assert.ErrorIs(t, fields["Factory"], pal.ErrFactoryServiceDependency)
+		assert.ErrorIs(t, fields["Scoped"], pal.ErrScopeIsNotInContext)
+		assert.ErrorIs(t, fields["Routes"], pal.ErrInvalidTag)
+		assert.ErrorIs(t, fields["Names"], pal.ErrInvalidTag)
+
+		for _, problem := range report.Problems {
+			assert.ErrorIs(t, err, problem.Err)
+		}
+	})
+
+	t.Run("returns problem when decorated service is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Decorate[storageBackend](prefixStorage("a-")))
+
+		_, err := p.Validate(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrServiceNotFound)
+	})
+
+	t.Run("returns problem when services form a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		p := newPal(pal.Provide(&validateCycleA{}), pal.Provide(&validateCycleB{}))
+
+		report, err := p.Validate(t.Context())
+
+		assert.ErrorIs(t, err, pal.ErrCycleDetected)
+		assert.Empty(t, report.InitOrder)
+	})
+}